@@ -1,5 +1,7 @@
 package base58
 
+import "fmt"
+
 // Alphabet is a a b58 alphabet.
 type Alphabet struct {
 	decode [128]int8
@@ -40,3 +42,44 @@ var BTCAlphabet = NewAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopq
 
 // FlickrAlphabet is the flickr base58 alphabet.
 var FlickrAlphabet = NewAlphabet("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ")
+
+// RippleAlphabet is the ripple base58 alphabet.
+var RippleAlphabet = NewAlphabet("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz")
+
+// String returns the 58 characters of the alphabet, in digit order.
+func (a *Alphabet) String() string {
+	return string(a.encode[:])
+}
+
+// IsPermutationOf reports whether a consists of exactly the same 58
+// characters as ref, in any order.
+func (a *Alphabet) IsPermutationOf(ref *Alphabet) bool {
+	for _, b := range a.encode {
+		if ref.decode[b] == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// validDigit reports whether c is one of the 58 characters of a.
+func (a *Alphabet) validDigit(c byte) bool {
+	return c < 128 && a.decode[c] != -1
+}
+
+// checkDigits returns an error naming the first character of s that is not
+// a digit of alph.
+func checkDigits(s string, alph *Alphabet) error {
+	for i := 0; i < len(s); i++ {
+		if !alph.validDigit(s[i]) {
+			return digitError(s[i], i)
+		}
+	}
+	return nil
+}
+
+// digitError returns the error for the invalid character c found at index i
+// of the input.
+func digitError(c byte, i int) error {
+	return fmt.Errorf("invalid base58 digit (%q) at input index: %d", c, i)
+}
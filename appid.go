@@ -0,0 +1,40 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrInvalidAppID is returned by DecodeAppID when the decoded data is too
+// short to hold a kind byte and a checksum.
+var ErrInvalidAppID = errors.New("invalid application identifier")
+
+// EncodeAppID encodes a typed, checksummed application identifier with the
+// passed alphabet: the kind byte, the payload and the Base58Check checksum
+// (the first 4 bytes of the double SHA256) of both.
+func EncodeAppID(kind uint8, payload []byte, alph *Alphabet) string {
+	buf := make([]byte, 0, 1+len(payload)+checksumLen)
+	buf = append(buf, kind)
+	buf = append(buf, payload...)
+	sum := checksum(buf)
+	return FastBase58EncodingAlphabet(append(buf, sum[:]...), alph)
+}
+
+// DecodeAppID decodes an identifier produced by EncodeAppID and returns its
+// kind and payload. It returns ErrInvalidAppID if the decoded data is too
+// short and ErrChecksumMismatch if the checksum does not match.
+func DecodeAppID(s string, alph *Alphabet) (kind uint8, payload []byte, err error) {
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(buf) < 1+checksumLen {
+		return 0, nil, ErrInvalidAppID
+	}
+	data := buf[:len(buf)-checksumLen]
+	sum := checksum(data)
+	if !bytes.Equal(sum[:], buf[len(data):]) {
+		return 0, nil, ErrChecksumMismatch
+	}
+	return data[0], data[1:], nil
+}
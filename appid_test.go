@@ -0,0 +1,40 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAppID(t *testing.T) {
+	payload := []byte{0, 1, 2, 3, 4, 5, 6, 7}
+	user := EncodeAppID(1, payload, BTCAlphabet)
+	order := EncodeAppID(2, payload, BTCAlphabet)
+	if user == order {
+		t.Errorf("expected different kinds to produce different identifiers")
+	}
+
+	for want, s := range map[uint8]string{1: user, 2: order} {
+		kind, dec, err := DecodeAppID(s, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", s, err)
+			continue
+		}
+		if kind != want || !bytes.Equal(dec, payload) {
+			t.Errorf("expected kind %d and %x, got %d and %x", want, payload, kind, dec)
+		}
+	}
+}
+
+func TestAppIDCorrupted(t *testing.T) {
+	s := []byte(EncodeAppID(1, []byte("payload"), BTCAlphabet))
+	s[3] = BTCAlphabet.encode[(BTCAlphabet.decode[s[3]]+1)%58]
+	if _, _, err := DecodeAppID(string(s), BTCAlphabet); err != ErrChecksumMismatch {
+		t.Errorf("expected ErrChecksumMismatch, got %v", err)
+	}
+	if _, _, err := DecodeAppID(FastBase58Encoding([]byte{1, 2, 3}), BTCAlphabet); err != ErrInvalidAppID {
+		t.Errorf("expected ErrInvalidAppID, got %v", err)
+	}
+	if _, _, err := DecodeAppID("0OIl", BTCAlphabet); err == nil || err == ErrChecksumMismatch || err == ErrInvalidAppID {
+		t.Errorf("expected a decoding error, got %v", err)
+	}
+}
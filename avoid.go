@@ -0,0 +1,22 @@
+package base58
+
+// EncodeAvoiding encodes the passed bytes with the passed alphabet and reports
+// whether the result contains none of the characters in avoid.
+//
+// The encoding of fixed data cannot be changed, so avoidance cannot be
+// guaranteed; the result is meant for retry loops that generate random IDs
+// until one is free of characters that are hard to tell apart in a given
+// font.
+func EncodeAvoiding(src []byte, avoid []byte, alph *Alphabet) (string, bool) {
+	enc := FastBase58EncodingAlphabet(src, alph)
+	var avoided [256]bool
+	for _, c := range avoid {
+		avoided[c] = true
+	}
+	for i := 0; i < len(enc); i++ {
+		if avoided[enc[i]] {
+			return enc, false
+		}
+	}
+	return enc, true
+}
@@ -0,0 +1,18 @@
+package base58
+
+import "testing"
+
+func TestEncodeAvoiding(t *testing.T) {
+	src, _ := FastBase58Decoding("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+
+	enc, ok := EncodeAvoiding(src, []byte("1l"), BTCAlphabet)
+	if enc != "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA" || !ok {
+		t.Errorf("expected the encoding to avoid 1 and l, got %s, %v", enc, ok)
+	}
+	if _, ok := EncodeAvoiding(src, []byte("xZ"), BTCAlphabet); ok {
+		t.Errorf("expected Z to be detected in the output")
+	}
+	if _, ok := EncodeAvoiding(src, nil, BTCAlphabet); !ok {
+		t.Errorf("expected nothing to avoid")
+	}
+}
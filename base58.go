@@ -1,6 +1,7 @@
 package base58
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -52,7 +53,22 @@ func _FastBase58EncodingAlphabetBytes(bin []byte, alphabet *Alphabet) []byte {
 		// ceil(log(256)/log(58))
 		(size-zcount)*555/406 + 1
 
-	out := make([]byte, size)
+	return encodeInto(make([]byte, size), bin, alphabet)
+}
+
+// encodeInto encodes bin into out and returns the encoded prefix of out. out
+// must hold at least len(bin)*555/406+1 bytes; only that many are used and
+// they are cleared first.
+func encodeInto(out, bin []byte, alphabet *Alphabet) []byte {
+	zcount := 0
+	for zcount < len(bin) && bin[zcount] == 0 {
+		zcount++
+	}
+	size := zcount + (len(bin)-zcount)*555/406 + 1
+	out = out[:size]
+	for i := range out {
+		out[i] = 0
+	}
 
 	var i, high int
 	var carry uint32
@@ -100,8 +116,22 @@ func FastBase58Decoding(str string) ([]byte, error) {
 // FastBase58DecodingAlphabet decodes the base58 encoded bytes using the given
 // b58 alphabet.
 func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error) {
+	// the decoded value is never longer than the encoded string
+	out := make([]byte, len(str))
+	n, err := decodeInto(out, nil, make([]uint32, (len(str)+3)/4), str, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
+
+// decodeInto decodes str into dst, continuing into wrap once dst is full, and
+// returns the decoded length. scratch must hold at least (len(str)+3)/4
+// elements. If the decoded value does not fit in dst and wrap combined,
+// nothing is written and the length is returned along with errShortDst.
+func decodeInto(dst, wrap []byte, scratch []uint32, str string, alphabet *Alphabet) (int, error) {
 	if len(str) == 0 {
-		return nil, fmt.Errorf("zero length string")
+		return 0, fmt.Errorf("zero length string")
 	}
 
 	zero := alphabet.encode[0]
@@ -114,16 +144,18 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 
 	var t, c uint64
 
-	// the 32bit algo stretches the result up to 2 times
-	binu := make([]byte, 2*((b58sz*406/555)+1))
-	outi := make([]uint32, (b58sz+3)/4)
+	outi := scratch[:(b58sz+3)/4]
+	for j := range outi {
+		outi[j] = 0
+	}
 
-	for _, r := range str {
+	for i := 0; i < b58sz; i++ {
+		r := str[i]
 		if r > 127 {
-			return nil, fmt.Errorf("high-bit set on invalid digit")
+			return 0, fmt.Errorf("high-bit set on invalid digit")
 		}
 		if alphabet.decode[r] == -1 {
-			return nil, fmt.Errorf("invalid base58 digit (%q)", r)
+			return 0, fmt.Errorf("invalid base58 digit (%q)", r)
 		}
 
 		c = uint64(alphabet.decode[r])
@@ -135,30 +167,38 @@ func FastBase58DecodingAlphabet(str string, alphabet *Alphabet) ([]byte, error)
 		}
 	}
 
-	// initial mask depends on b58sz, on further loops it always starts at 24 bits
-	mask := (uint(b58sz%4) * 8)
-	if mask == 0 {
-		mask = 32
+	// The limbs hold the value as b58sz big-endian bytes, preceded by skip
+	// unused bytes in the first limb.
+	skip := 4*len(outi) - b58sz
+	byteAt := func(k int) byte {
+		k += skip
+		return byte(outi[k/4] >> (uint(3-k%4) * 8))
 	}
-	mask -= 8
 
-	outLen := 0
-	for j := 0; j < len(outi); j++ {
-		for mask < 32 { // loop relies on uint overflow
-			binu[outLen] = byte(outi[j] >> mask)
-			mask -= 8
-			outLen++
-		}
-		mask = 24
+	// find the most significant byte post-decode, if any; everything before
+	// it is replaced by the zcount leading zeroes
+	msb := zcount
+	for msb < b58sz && byteAt(msb) == 0 {
+		msb++
+	}
+	size := zcount + b58sz - msb
+	if size > len(dst)+len(wrap) {
+		return size, errShortDst
 	}
 
-	// find the most significant byte post-decode, if any
-	for msb := zcount; msb < len(binu); msb++ {
-		if binu[msb] > 0 {
-			return binu[msb-zcount : outLen], nil
+	for k := 0; k < size; k++ {
+		var b byte
+		if k >= zcount {
+			b = byteAt(msb + k - zcount)
+		}
+		if k < len(dst) {
+			dst[k] = b
+		} else {
+			wrap[k-len(dst)] = b
 		}
 	}
-
-	// it's all zeroes
-	return binu[:outLen], nil
+	return size, nil
 }
+
+// errShortDst is returned by decodeInto when the destination is too small.
+var errShortDst = errors.New("destination too small for decoded value")
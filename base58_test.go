@@ -1,6 +1,7 @@
 package base58
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math/rand"
 	"testing"
@@ -198,3 +199,69 @@ func TestSanityCheck(t *testing.T) {
 		}
 	}
 }
+
+// TestFastEqTrivialAllOnes checks inputs made of 0xFF bytes, which produce the
+// longest carry chains in the fast encoder and are unlikely to come up in the
+// random loops above.
+func TestFastEqTrivialAllOnes(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 8, 16, 25, 31, 32, 33, 64, 128, 255} {
+		ones := bytes.Repeat([]byte{0xFF}, n)
+		inputs := [][]byte{
+			ones,
+			append(append([]byte{}, ones...), 0),
+			append([]byte{0}, ones...),
+			append(append([]byte{0, 0}, ones...), 0, 0),
+		}
+		for _, b := range inputs {
+			fe := FastBase58Encoding(b)
+			te := TrivialBase58Encoding(b)
+			if fe != te {
+				t.Errorf("encoding err for %s: %s != %s", hex.EncodeToString(b), fe, te)
+			}
+			fd, err := FastBase58Decoding(fe)
+			if err != nil {
+				t.Errorf("fast error: %v", err)
+			}
+			if !bytes.Equal(fd, b) {
+				t.Errorf("decoding err: %s != %s", hex.EncodeToString(b), hex.EncodeToString(fd))
+			}
+		}
+	}
+
+	if enc := FastBase58Encoding(bytes.Repeat([]byte{0xFF}, 32)); enc != "JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFG" {
+		t.Errorf("unexpected encoding of 32 0xFF bytes: %s", enc)
+	}
+}
+
+func TestIsPermutationOf(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, RippleAlphabet} {
+		if !alph.IsPermutationOf(BTCAlphabet) || !BTCAlphabet.IsPermutationOf(alph) {
+			t.Errorf("expected %s to be a permutation of the bitcoin alphabet", alph)
+		}
+	}
+
+	withZero := NewAlphabet("0" + btcDigits[1:])
+	if withZero.IsPermutationOf(BTCAlphabet) || BTCAlphabet.IsPermutationOf(withZero) {
+		t.Errorf("expected %s not to be a permutation of the bitcoin alphabet", withZero)
+	}
+	if randAlphabet().IsPermutationOf(BTCAlphabet) {
+		t.Errorf("expected a random alphabet not to be a permutation of the bitcoin alphabet")
+	}
+}
+
+func TestCheckDigits(t *testing.T) {
+	if err := checkDigits(btcDigits, BTCAlphabet); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, tc := range []struct {
+		s    string
+		want string
+	}{
+		{"2g0", `invalid base58 digit ('0') at input index: 2`},
+		{"\xFF", `invalid base58 digit ('ÿ') at input index: 0`},
+	} {
+		if err := checkDigits(tc.s, BTCAlphabet); err == nil || err.Error() != tc.want {
+			t.Errorf("%q: expected %q, got %v", tc.s, tc.want, err)
+		}
+	}
+}
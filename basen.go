@@ -0,0 +1,145 @@
+package base58
+
+import (
+	"fmt"
+	"math"
+)
+
+// BaseNAlphabet is an alphabet for a generic base-N encoding, where N is the
+// length of the alphabet (2 to 58 characters).
+//
+// The encoding follows the same rules as base58: every leading zero byte is
+// encoded as a leading zero digit (the first character of the alphabet),
+// and the remaining bytes are treated as a big-endian number.
+//
+// Only 58 character alphabets use the optimized base58 codec; all other
+// radixes use a slower generic conversion.
+type BaseNAlphabet struct {
+	decode [128]int8
+	encode []byte
+	b58    *Alphabet
+}
+
+// NewBaseNAlphabet creates a new base-N alphabet from the passed string.
+//
+// It returns an error if the passed string is not between 2 and 58 bytes
+// long, isn't valid ASCII, or does not consist of distinct characters.
+func NewBaseNAlphabet(s string) (*BaseNAlphabet, error) {
+	if len(s) < 2 || len(s) > 58 {
+		return nil, fmt.Errorf("base-N alphabets must be between 2 and 58 bytes long")
+	}
+	ret := &BaseNAlphabet{encode: []byte(s)}
+	for i := range ret.decode {
+		ret.decode[i] = -1
+	}
+	for i, b := range ret.encode {
+		if b > 127 {
+			return nil, fmt.Errorf("provided alphabet contains non-ascii character (%q)", b)
+		}
+		if ret.decode[b] != -1 {
+			return nil, fmt.Errorf("provided alphabet does not consist of distinct characters")
+		}
+		ret.decode[b] = int8(i)
+	}
+	if len(s) == 58 {
+		ret.b58 = NewAlphabet(s)
+	}
+	return ret, nil
+}
+
+// Radix returns the base of the alphabet.
+func (a *BaseNAlphabet) Radix() int {
+	return len(a.encode)
+}
+
+// Encode encodes the passed bytes into a base-N encoded string.
+func (a *BaseNAlphabet) Encode(bin []byte) string {
+	if a.b58 != nil {
+		return FastBase58EncodingAlphabet(bin, a.b58)
+	}
+	if len(bin) == 0 {
+		return ""
+	}
+
+	radix := uint32(len(a.encode))
+	size := len(bin)
+
+	zcount := 0
+	for zcount < size && bin[zcount] == 0 {
+		zcount++
+	}
+
+	size = zcount + int(float64(size-zcount)*math.Log(256)/math.Log(float64(radix))) + 1
+
+	out := make([]byte, size)
+
+	var i, high int
+	var carry uint32
+
+	high = size - 1
+	for _, b := range bin {
+		i = size - 1
+		for carry = uint32(b); i > high || carry != 0; i-- {
+			carry = carry + 256*uint32(out[i])
+			out[i] = byte(carry % radix)
+			carry /= radix
+		}
+		high = i
+	}
+
+	for i = zcount; i < size && out[i] == 0; i++ {
+	}
+
+	val := out[i-zcount:]
+	for i = range val {
+		val[i] = a.encode[val[i]]
+	}
+	return string(val)
+}
+
+// Decode decodes the base-N encoded string.
+func (a *BaseNAlphabet) Decode(str string) ([]byte, error) {
+	if a.b58 != nil {
+		return FastBase58DecodingAlphabet(str, a.b58)
+	}
+	if len(str) == 0 {
+		return nil, fmt.Errorf("zero length string")
+	}
+
+	radix := uint32(len(a.encode))
+	zero := a.encode[0]
+
+	var zcount int
+	for zcount < len(str) && str[zcount] == zero {
+		zcount++
+	}
+
+	size := int(float64(len(str)-zcount)*math.Log(float64(radix))/math.Log(256)) + 1
+	out := make([]byte, zcount+size)
+
+	var i, high int
+	var carry uint32
+
+	high = len(out) - 1
+	for j := zcount; j < len(str); j++ {
+		r := str[j]
+		if r > 127 {
+			return nil, fmt.Errorf("high-bit set on invalid digit")
+		}
+		if a.decode[r] == -1 {
+			return nil, fmt.Errorf("invalid base-N digit (%q)", r)
+		}
+
+		i = len(out) - 1
+		for carry = uint32(a.decode[r]); i > high || carry != 0; i-- {
+			carry = carry + radix*uint32(out[i])
+			out[i] = byte(carry)
+			carry >>= 8
+		}
+		high = i
+	}
+
+	for i = zcount; i < len(out) && out[i] == 0; i++ {
+	}
+	return out[i-zcount:], nil
+}
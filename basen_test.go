@@ -0,0 +1,81 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestNewBaseNAlphabetInvalid(t *testing.T) {
+	for _, s := range []string{"", "0", "00", "\xFF1", "0" + btcDigits} {
+		if _, err := NewBaseNAlphabet(s); err == nil {
+			t.Errorf("expected error for alphabet %q", s)
+		}
+	}
+}
+
+func TestBaseNRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"0123456789abcdef",
+		"0123456789abcdefghijklmnopqrstuvwxyz",
+		"01",
+		btcDigits,
+	} {
+		alph, err := NewBaseNAlphabet(s)
+		if err != nil {
+			t.Fatalf("unexpected error for alphabet %q: %v", s, err)
+		}
+		for j := 1; j < 64; j++ {
+			b := make([]byte, j)
+			for i := 0; i < 20; i++ {
+				rand.Read(b)
+				if i%4 == 0 {
+					b[0] = 0
+				}
+				enc := alph.Encode(b)
+				if want := trivialBaseN(b, s); enc != want {
+					t.Errorf("base%d encoding err: %s: %s != %s", len(s), hex.EncodeToString(b), enc, want)
+				}
+				dec, err := alph.Decode(enc)
+				if err != nil {
+					t.Errorf("base%d decoding error: %v", len(s), err)
+				}
+				if !bytes.Equal(dec, b) {
+					t.Errorf("base%d decoding err: %s != %s", len(s), hex.EncodeToString(b), hex.EncodeToString(dec))
+				}
+			}
+		}
+	}
+}
+
+func TestBaseNKnownValues(t *testing.T) {
+	base16, _ := NewBaseNAlphabet("0123456789abcdef")
+	base36, _ := NewBaseNAlphabet("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	if enc := base16.Encode([]byte{0, 0xde, 0xad}); enc != "0dead" {
+		t.Errorf("expected 0dead, got %s", enc)
+	}
+	if enc := base36.Encode([]byte{0x01, 0x00}); enc != "74" {
+		t.Errorf("expected 74, got %s", enc)
+	}
+	if _, err := base36.Decode("zz-"); err == nil {
+		t.Errorf("expected error on invalid digit")
+	}
+}
+
+func trivialBaseN(a []byte, alphabet string) string {
+	radix := big.NewInt(int64(len(alphabet)))
+	bn := new(big.Int).SetBytes(a)
+	mo := new(big.Int)
+	var out []byte
+	for bn.Sign() != 0 {
+		bn.DivMod(bn, radix, mo)
+		out = append([]byte{alphabet[mo.Int64()]}, out...)
+	}
+	for i := 0; i < len(a) && a[i] == 0; i++ {
+		out = append([]byte{alphabet[0]}, out...)
+	}
+	return string(out)
+}
@@ -0,0 +1,24 @@
+package base58
+
+import "context"
+
+// DecodeBatchContext decodes each of the passed inputs in order using the
+// passed alphabet, checking ctx before every item. The results and errors are
+// index aligned with inputs.
+//
+// Once ctx is cancelled or its deadline passes, the remaining inputs are not
+// decoded and their errors are set to ctx.Err().
+func DecodeBatchContext(ctx context.Context, inputs []string, alph *Alphabet) ([][]byte, []error) {
+	results := make([][]byte, len(inputs))
+	errs := make([]error, len(inputs))
+	for i, s := range inputs {
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(inputs); j++ {
+				errs[j] = err
+			}
+			break
+		}
+		results[i], errs[i] = FastBase58DecodingAlphabet(s, alph)
+	}
+	return results, errs
+}
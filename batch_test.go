@@ -0,0 +1,53 @@
+package base58
+
+import (
+	"context"
+	"testing"
+)
+
+// cancelAfterCtx reports itself as cancelled after Err has been called n
+// times.
+type cancelAfterCtx struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfterCtx) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestDecodeBatchContext(t *testing.T) {
+	inputs := []string{"2", "0", "21", "z", "zz"}
+
+	results, errs := DecodeBatchContext(&cancelAfterCtx{context.Background(), 3}, inputs, BTCAlphabet)
+	if len(results) != len(inputs) || len(errs) != len(inputs) {
+		t.Fatalf("expected %d results and errors, got %d and %d", len(inputs), len(results), len(errs))
+	}
+	if errs[0] != nil || len(results[0]) != 1 || results[0][0] != 1 {
+		t.Errorf("expected input 0 to decode, got %x (%v)", results[0], errs[0])
+	}
+	if errs[1] == nil || errs[1] == context.Canceled {
+		t.Errorf("expected a decoding error for input 1, got %v", errs[1])
+	}
+	if errs[2] != nil || len(results[2]) != 1 || results[2][0] != 58 {
+		t.Errorf("expected input 2 to decode, got %x (%v)", results[2], errs[2])
+	}
+	for i := 3; i < len(inputs); i++ {
+		if errs[i] != context.Canceled || results[i] != nil {
+			t.Errorf("expected input %d to be cancelled, got %x (%v)", i, results[i], errs[i])
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, errs = DecodeBatchContext(ctx, inputs, BTCAlphabet)
+	for i, err := range errs {
+		if err != context.Canceled {
+			t.Errorf("expected input %d to be cancelled, got %v", i, err)
+		}
+	}
+}
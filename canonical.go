@@ -0,0 +1,42 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotAllowed is returned by DecodeIfAllowed when the input is not in the
+// allowlist.
+var ErrNotAllowed = errors.New("base58 string is not in the allowlist")
+
+// Canonicalize validates the base58 encoded string using the passed alphabet
+// and returns its canonical form, obtained by decoding and re-encoding it.
+//
+// It is strict: the input is not trimmed or otherwise cleaned up, and any
+// character outside of the alphabet is an error.
+func Canonicalize(s string, alph *Alphabet) (string, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return "", fmt.Errorf("invalid base58 string: %v", err)
+	}
+	return FastBase58EncodingAlphabet(b, alph), nil
+}
+
+// DecodeIfAllowed decodes the base58 encoded string using the passed alphabet
+// only if its canonical form is present in allow; otherwise it returns
+// ErrNotAllowed.
+//
+// The input is trimmed of surrounding whitespace and canonicalized before
+// the allowlist is consulted, so a differently spelled form of a pinned
+// value cannot bypass the check. The keys of allow must be canonical.
+func DecodeIfAllowed(s string, allow map[string]struct{}, alph *Alphabet) ([]byte, error) {
+	b, err := FastBase58DecodingAlphabet(strings.TrimSpace(s), alph)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base58 string: %v", err)
+	}
+	if _, ok := allow[FastBase58EncodingAlphabet(b, alph)]; !ok {
+		return nil, ErrNotAllowed
+	}
+	return b, nil
+}
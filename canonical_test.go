@@ -0,0 +1,55 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	testCases := []string{
+		"ComputeBudget111111111111111111111111111111",
+		"11111111111111111111111111111111",
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq",
+	}
+	for _, tc := range testCases {
+		c, err := Canonicalize(tc, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", tc, err)
+			continue
+		}
+		if c != tc {
+			t.Errorf("expected %s to be unchanged, got %s", tc, c)
+		}
+	}
+
+	for _, s := range []string{"", " 1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", "0OIl"} {
+		if _, err := Canonicalize(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestDecodeIfAllowed(t *testing.T) {
+	pinned := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	allow := map[string]struct{}{pinned: {}}
+
+	want, _ := FastBase58Decoding(pinned)
+	for _, s := range []string{pinned, " " + pinned + "\n"} {
+		dec, err := DecodeIfAllowed(s, allow, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", s, err)
+			continue
+		}
+		if !bytes.Equal(dec, want) {
+			t.Errorf("expected %x, got %x", want, dec)
+		}
+	}
+
+	if _, err := DecodeIfAllowed("ComputeBudget111111111111111111111111111111", allow, BTCAlphabet); err != ErrNotAllowed {
+		t.Errorf("expected ErrNotAllowed, got %v", err)
+	}
+	if _, err := DecodeIfAllowed("0OIl", allow, BTCAlphabet); err == nil || err == ErrNotAllowed {
+		t.Errorf("expected decoding error, got %v", err)
+	}
+}
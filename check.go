@@ -0,0 +1,45 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+// ErrChecksumMismatch is returned when decoded data does not match its
+// embedded checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// checksumLen is the length of a Base58Check checksum.
+const checksumLen = 4
+
+// checksum returns the Base58Check checksum of b: the first four bytes of
+// the double SHA256 hash.
+func checksum(b []byte) [checksumLen]byte {
+	h1 := sha256.Sum256(b)
+	h2 := sha256.Sum256(h1[:])
+	var sum [checksumLen]byte
+	copy(sum[:], h2[:checksumLen])
+	return sum
+}
+
+// validChecksum reports whether the last four bytes of b are the checksum
+// of the bytes that precede them.
+func validChecksum(b []byte) bool {
+	if len(b) < checksumLen {
+		return false
+	}
+	payload := b[:len(b)-checksumLen]
+	sum := checksum(payload)
+	return bytes.Equal(sum[:], b[len(payload):])
+}
+
+// VerifyCheck reports whether s is a valid Base58Check encoded string, i.e.
+// its decoded bytes end with the checksum of the preceding payload.
+func VerifyCheck(s string) bool {
+	b, err := FastBase58DecodingAlphabet(s, BTCAlphabet)
+	if err != nil {
+		return false
+	}
+	return validChecksum(b)
+}
@@ -0,0 +1,30 @@
+package base58
+
+import "testing"
+
+func TestVerifyCheck(t *testing.T) {
+	valid := []string{
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq",
+		"1DhRmSGnhPjUaVPAj48zgPV9e2oRhAQFUb",
+		"17LN2oPYRYsXS9TdYdXCCDvF2FegshLDU2",
+		"14h2bDLZSuvRFhUL45VjPHJcW667mmRAAn",
+	}
+	for _, s := range valid {
+		if !VerifyCheck(s) {
+			t.Errorf("expected %s to have a valid checksum", s)
+		}
+	}
+
+	invalid := []string{
+		"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojr",
+		"1DhRmSGnhPjUaVPAj48zgPV9e2oRhAQFUc",
+		"",
+		"1",
+		"0OIl",
+	}
+	for _, s := range invalid {
+		if VerifyCheck(s) {
+			t.Errorf("expected %s to have an invalid checksum", s)
+		}
+	}
+}
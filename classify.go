@@ -0,0 +1,91 @@
+package base58
+
+// Kind is the probable type of a decoded base58 value, as inferred by
+// Classify and DecodeTyped.
+type Kind int
+
+// The kinds of values recognized by Classify and DecodeTyped.
+const (
+	Unknown Kind = iota
+	PublicKey
+	Signature
+	Base58CheckAddress
+)
+
+// String returns the label used by Classify for the kind.
+func (k Kind) String() string {
+	switch k {
+	case PublicKey:
+		return "solana-pubkey"
+	case Signature:
+		return "solana-signature"
+	case Base58CheckAddress:
+		return "base58check-address"
+	default:
+		return "unknown"
+	}
+}
+
+// kindOf heuristically determines the kind of the decoded bytes, with the
+// precedence documented on Classify.
+func kindOf(data []byte) Kind {
+	switch {
+	case len(data) == 32:
+		return PublicKey
+	case len(data) == 64:
+		return Signature
+	case validChecksum(data):
+		return Base58CheckAddress
+	default:
+		return Unknown
+	}
+}
+
+// Classify decodes the base58 encoded string using the passed alphabet and
+// heuristically labels the decoded bytes.
+//
+// The checks are applied in order and the first match wins:
+//
+//	"solana-pubkey"       the value is 32 bytes long
+//	"solana-signature"    the value is 64 bytes long
+//	"base58check-address" the last 4 bytes are a valid Base58Check checksum
+//	"unknown"             none of the above
+func Classify(s string, alph *Alphabet) (data []byte, probableType string, err error) {
+	data, err = FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, kindOf(data).String(), nil
+}
+
+// TypedValue describes a decoded base58 value.
+type TypedValue struct {
+	// Bytes is the decoded value.
+	Bytes []byte
+	// Length is the length of Bytes.
+	Length int
+	// LeadingZeros is the number of leading zero bytes of the value.
+	LeadingZeros int
+	// Kind is the probable type of the value, as reported by Classify.
+	Kind Kind
+}
+
+// DecodeTyped decodes the base58 encoded string using the passed alphabet and
+// describes the result in a single pass. The Kind follows the same
+// heuristic as Classify.
+func DecodeTyped(s string, alph *Alphabet) (*TypedValue, error) {
+	data, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+	zcount := 0
+	for zcount < len(data) && data[zcount] == 0 {
+		zcount++
+	}
+	return &TypedValue{
+		Bytes:        data,
+		Length:       len(data),
+		LeadingZeros: zcount,
+		Kind:         kindOf(data),
+	}, nil
+}
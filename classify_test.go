@@ -0,0 +1,69 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	sig := FastBase58Encoding(bytes.Repeat([]byte{0xAB}, 64))
+	testCases := []struct {
+		enc string
+		typ string
+	}{
+		{"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "solana-pubkey"},
+		{"11111111111111111111111111111111", "solana-pubkey"},
+		{sig, "solana-signature"},
+		{"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", "base58check-address"},
+		{"1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojr", "unknown"},
+		{"z", "unknown"},
+	}
+	for _, tc := range testCases {
+		data, typ, err := Classify(tc.enc, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", tc.enc, err)
+			continue
+		}
+		if typ != tc.typ {
+			t.Errorf("expected %s for %s, got %s", tc.typ, tc.enc, typ)
+		}
+		if FastBase58Encoding(data) != tc.enc {
+			t.Errorf("expected data to round-trip for %s", tc.enc)
+		}
+	}
+
+	if _, _, err := Classify("0OIl", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+}
+
+func TestDecodeTyped(t *testing.T) {
+	sig := bytes.Repeat([]byte{0xAB}, 64)
+	v, err := DecodeTyped(FastBase58Encoding(sig), BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Kind != Signature || v.Length != 64 || v.LeadingZeros != 0 || !bytes.Equal(v.Bytes, sig) {
+		t.Errorf("unexpected typed value: %+v", v)
+	}
+
+	v, err = DecodeTyped("11111111111111111111111111111111", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Kind != PublicKey || v.Length != 32 || v.LeadingZeros != 32 {
+		t.Errorf("unexpected typed value: %+v", v)
+	}
+
+	v, err = DecodeTyped("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Kind != Base58CheckAddress || v.Length != 25 || v.LeadingZeros != 1 {
+		t.Errorf("unexpected typed value: %+v", v)
+	}
+
+	if _, err := DecodeTyped("0OIl", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+}
@@ -0,0 +1,37 @@
+package base58
+
+import "crypto/subtle"
+
+// ConstantTimeEquals decodes a and b using the passed alphabet and reports
+// whether they hold the same value, comparing the decoded bytes in constant
+// time. The shorter value is left-padded with zero bytes first, so leading
+// zero digits do not affect the result.
+//
+// Only the comparison is constant time; decoding time still depends on the
+// length of the inputs.
+func ConstantTimeEquals(a, b string, alph *Alphabet) (bool, error) {
+	da, err := FastBase58DecodingAlphabet(a, alph)
+	if err != nil {
+		return false, err
+	}
+	db, err := FastBase58DecodingAlphabet(b, alph)
+	if err != nil {
+		return false, err
+	}
+	if len(da) < len(db) {
+		da = leftPad(da, len(db))
+	} else {
+		db = leftPad(db, len(da))
+	}
+	return subtle.ConstantTimeCompare(da, db) == 1, nil
+}
+
+// leftPad returns b left-padded with zero bytes to size bytes.
+func leftPad(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	out := make([]byte, size)
+	copy(out[size-len(b):], b)
+	return out
+}
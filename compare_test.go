@@ -0,0 +1,32 @@
+package base58
+
+import "testing"
+
+func TestConstantTimeEquals(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		eq   bool
+	}{
+		{"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", true},
+		{"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DB", false},
+		{"11z", "z", true},
+		{"z", "21", false},
+	}
+	for _, tc := range testCases {
+		eq, err := ConstantTimeEquals(tc.a, tc.b, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s/%s: %v", tc.a, tc.b, err)
+			continue
+		}
+		if eq != tc.eq {
+			t.Errorf("expected %v for %s/%s, got %v", tc.eq, tc.a, tc.b, eq)
+		}
+	}
+
+	if _, err := ConstantTimeEquals("0", "z", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+	if _, err := ConstantTimeEquals("z", "0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+}
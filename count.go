@@ -0,0 +1,53 @@
+package base58
+
+import (
+	"bufio"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CountValidTokens reads whitespace-separated tokens from r and counts how
+// many are valid base58 strings under the passed alphabet and how many are
+// not.
+//
+// Tokens are checked character by character as they are read and never
+// retained, so memory use is bounded regardless of the size of the stream
+// or of individual tokens. The returned error is the first read error other
+// than io.EOF.
+func CountValidTokens(r io.Reader, alph *Alphabet) (valid, invalid int, err error) {
+	br := bufio.NewReader(r)
+	inToken, ok := false, true
+	endToken := func() {
+		if !inToken {
+			return
+		}
+		if ok {
+			valid++
+		} else {
+			invalid++
+		}
+		inToken = false
+	}
+	for {
+		c, _, rerr := br.ReadRune()
+		if rerr != nil {
+			endToken()
+			if rerr == io.EOF {
+				rerr = nil
+			}
+			return valid, invalid, rerr
+		}
+
+		if unicode.IsSpace(c) {
+			endToken()
+			continue
+		}
+		if !inToken {
+			inToken, ok = true, true
+		}
+		if c >= utf8.RuneSelf || !alph.validDigit(byte(c)) {
+			ok = false
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package base58
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCountValidTokens(t *testing.T) {
+	input := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA 0x1234\n\n" +
+		"11111111111111111111111111111111\tnot-base58 l0l\n  z  " + strings.Repeat("2", 100000) + "O"
+	valid, invalid, err := CountValidTokens(strings.NewReader(input), BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if valid != 3 || invalid != 4 {
+		t.Errorf("expected 3 valid and 4 invalid tokens, got %d and %d", valid, invalid)
+	}
+
+	if valid, invalid, err := CountValidTokens(strings.NewReader(" \n "), BTCAlphabet); valid != 0 || invalid != 0 || err != nil {
+		t.Errorf("expected no tokens, got %d, %d, %v", valid, invalid, err)
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestCountValidTokensReadError(t *testing.T) {
+	readErr := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("abc def"), errReader{readErr})
+	valid, invalid, err := CountValidTokens(r, BTCAlphabet)
+	if err != readErr {
+		t.Errorf("expected read error, got %v", err)
+	}
+	if valid != 2 || invalid != 0 {
+		t.Errorf("expected 2 valid tokens before the error, got %d and %d", valid, invalid)
+	}
+}
@@ -0,0 +1,39 @@
+package base58
+
+import "fmt"
+
+// DiffToken returns the base58 encoding, with the passed alphabet, of the XOR
+// of two equal-length keys. Identical keys produce a string of zero digits,
+// and keys sharing leading bytes produce a shorter-looking token.
+//
+// It returns an error if the keys are not the same length.
+func DiffToken(oldKey, newKey []byte, alph *Alphabet) (string, error) {
+	if len(oldKey) != len(newKey) {
+		return "", fmt.Errorf("keys are %d and %d bytes long, expected equal lengths", len(oldKey), len(newKey))
+	}
+	return FastBase58EncodingAlphabet(xorBytes(oldKey, newKey), alph), nil
+}
+
+// ApplyDiffToken reverses DiffToken: it decodes the token and XORs it with
+// oldKey, returning the new key.
+func ApplyDiffToken(oldKey []byte, token string, alph *Alphabet) ([]byte, error) {
+	if len(oldKey) == 0 && len(token) == 0 {
+		return []byte{}, nil
+	}
+	diff, err := FastBase58DecodingAlphabet(token, alph)
+	if err != nil {
+		return nil, err
+	}
+	if len(diff) != len(oldKey) {
+		return nil, fmt.Errorf("diff token is %d bytes long, expected %d", len(diff), len(oldKey))
+	}
+	return xorBytes(oldKey, diff), nil
+}
+
+func xorBytes(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDiffToken(t *testing.T) {
+	oldKey := make([]byte, 32)
+	rand.Read(oldKey)
+	newKey := append([]byte(nil), oldKey...)
+	newKey[31] ^= 0x01
+
+	token, err := DiffToken(oldKey, newKey, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != strings.Repeat("1", 31)+"2" {
+		t.Errorf("expected a short token for a single bit change, got %s", token)
+	}
+	got, err := ApplyDiffToken(oldKey, token, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, newKey) {
+		t.Errorf("expected %x, got %x", newKey, got)
+	}
+
+	rand.Read(newKey)
+	token, _ = DiffToken(oldKey, newKey, BTCAlphabet)
+	got, err = ApplyDiffToken(oldKey, token, BTCAlphabet)
+	if err != nil || !bytes.Equal(got, newKey) {
+		t.Errorf("expected %x, got %x (%v)", newKey, got, err)
+	}
+
+	if token, _ := DiffToken(oldKey, oldKey, BTCAlphabet); token != strings.Repeat("1", 32) {
+		t.Errorf("expected zero token for identical keys, got %s", token)
+	}
+}
+
+func TestApplyDiffTokenLengthMismatch(t *testing.T) {
+	if _, err := ApplyDiffToken(make([]byte, 32), "111", BTCAlphabet); err == nil {
+		t.Errorf("expected error on length mismatch")
+	}
+	if _, err := DiffToken(make([]byte, 32), make([]byte, 31), BTCAlphabet); err == nil {
+		t.Errorf("expected error on keys of different length")
+	}
+}
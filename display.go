@@ -0,0 +1,27 @@
+package base58
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EncodeFramed encodes the passed bytes with the passed alphabet and
+// surrounds the result with the open and close delimiters, e.g. "[" and "]".
+func EncodeFramed(src []byte, open, close string, alph *Alphabet) string {
+	return open + FastBase58EncodingAlphabet(src, alph) + close
+}
+
+// DecodeFramed strips the open and close delimiters from s and decodes the
+// remainder using the passed alphabet. It returns an error if either
+// delimiter is missing. Empty framing, as produced by EncodeFramed for empty
+// input, decodes to an empty value.
+func DecodeFramed(s, open, close string, alph *Alphabet) ([]byte, error) {
+	if len(s) < len(open)+len(close) || !strings.HasPrefix(s, open) || !strings.HasSuffix(s, close) {
+		return nil, fmt.Errorf("missing framing %q...%q", open, close)
+	}
+	body := s[len(open) : len(s)-len(close)]
+	if len(body) == 0 {
+		return []byte{}, nil
+	}
+	return FastBase58DecodingAlphabet(body, alph)
+}
@@ -0,0 +1,38 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFramed(t *testing.T) {
+	src := []byte{0, 1, 2, 3}
+	s := EncodeFramed(src, "[", "]", BTCAlphabet)
+	if want := "[" + FastBase58Encoding(src) + "]"; s != want {
+		t.Errorf("expected %s, got %s", want, s)
+	}
+	dec, err := DecodeFramed(s, "[", "]", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(dec, src) {
+		t.Errorf("expected %x, got %x", src, dec)
+	}
+
+	if dec, err := DecodeFramed(EncodeFramed(src, "«", "»", BTCAlphabet), "«", "»", BTCAlphabet); err != nil || !bytes.Equal(dec, src) {
+		t.Errorf("expected %x, got %x (%v)", src, dec, err)
+	}
+
+	if s := EncodeFramed(nil, "[", "]", BTCAlphabet); s != "[]" {
+		t.Errorf("expected [] for empty input, got %s", s)
+	}
+	if dec, err := DecodeFramed("[]", "[", "]", BTCAlphabet); err != nil || len(dec) != 0 {
+		t.Errorf("expected an empty value for [], got %x (%v)", dec, err)
+	}
+
+	for _, s := range []string{"1Ldp", "[1Ldp", "1Ldp]", "]", "][", "(1Ldp)"} {
+		if _, err := DecodeFramed(s, "[", "]", BTCAlphabet); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
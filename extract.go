@@ -0,0 +1,24 @@
+package base58
+
+// ExtractAll scans text for maximal runs of characters of the passed
+// alphabet that are at least minLen characters long and returns them in the
+// order they appear.
+//
+// The returned strings are only candidates: they are not decoded.
+func ExtractAll(text string, alph *Alphabet, minLen int) []string {
+	var out []string
+	start := -1
+	for i := 0; i <= len(text); i++ {
+		if i < len(text) && alph.validDigit(text[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 && i-start >= minLen {
+			out = append(out, text[start:i])
+		}
+		start = -1
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+package base58
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractAll(t *testing.T) {
+	text := "transfer from TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA to (11111111111111111111111111111111), fee=5000"
+	got := ExtractAll(text, BTCAlphabet, 32)
+	want := []string{
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"11111111111111111111111111111111",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := ExtractAll("no keys here, just words", BTCAlphabet, 32); len(got) != 0 {
+		t.Errorf("expected no candidates, got %v", got)
+	}
+	if got := ExtractAll("abc\xFFdef", BTCAlphabet, 3); !reflect.DeepEqual(got, []string{"abc", "def"}) {
+		t.Errorf("expected [abc def], got %v", got)
+	}
+}
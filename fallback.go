@@ -0,0 +1,49 @@
+package base58
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DecodeTryAlphabets attempts to decode the base58 encoded string with each
+// of the passed alphabets in order, and returns the result of the first one
+// that succeeds along with that alphabet.
+//
+// This is best-effort: a string will often decode successfully under
+// several alphabets (yielding different bytes), so the order of the passed
+// alphabets matters. If every alphabet fails, the returned error contains
+// all of the individual errors.
+func DecodeTryAlphabets(s string, alphabets ...*Alphabet) ([]byte, *Alphabet, error) {
+	if len(alphabets) == 0 {
+		return nil, nil, fmt.Errorf("no alphabets to try")
+	}
+	errs := make([]string, 0, len(alphabets))
+	for i, alph := range alphabets {
+		b, err := FastBase58DecodingAlphabet(s, alph)
+		if err == nil {
+			return b, alph, nil
+		}
+		errs = append(errs, fmt.Sprintf("alphabet %d: %v", i, err))
+	}
+	return nil, nil, fmt.Errorf("no alphabet could decode input: %s", strings.Join(errs, "; "))
+}
+
+// DiagnoseAlphabet returns, for each candidate alphabet, the byte indices of
+// the characters of s that are not part of that alphabet. An empty (non-nil)
+// slice means s is entirely valid under the candidate.
+//
+// Note that alphabets which are permutations of one another, such as the
+// bitcoin, flickr and ripple alphabets, always yield the same result.
+func DiagnoseAlphabet(s string, candidates []*Alphabet) map[*Alphabet][]int {
+	diag := make(map[*Alphabet][]int, len(candidates))
+	for _, alph := range candidates {
+		bad := []int{}
+		for i := 0; i < len(s); i++ {
+			if !alph.validDigit(s[i]) {
+				bad = append(bad, i)
+			}
+		}
+		diag[alph] = bad
+	}
+	return diag
+}
@@ -0,0 +1,50 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeTryAlphabets(t *testing.T) {
+	// The leading zero byte encodes as '1', which this alphabet lacks.
+	noOne := NewAlphabet("0" + btcDigits[1:])
+	src := []byte("\x00flickr")
+	enc := FastBase58EncodingAlphabet(src, FlickrAlphabet)
+
+	dec, alph, err := DecodeTryAlphabets(enc, noOne, FlickrAlphabet, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error for %s: %v", enc, err)
+	}
+	if alph != FlickrAlphabet {
+		t.Errorf("expected flickr alphabet to decode %s", enc)
+	}
+	if !bytes.Equal(dec, src) {
+		t.Errorf("expected %x, got %x", src, dec)
+	}
+
+	if _, _, err := DecodeTryAlphabets("0OIl", BTCAlphabet, FlickrAlphabet); err == nil {
+		t.Errorf("expected error when no alphabet can decode input")
+	}
+	if _, _, err := DecodeTryAlphabets(enc); err == nil {
+		t.Errorf("expected error with no alphabets")
+	}
+}
+
+func TestDiagnoseAlphabet(t *testing.T) {
+	// '0' is invalid in the bitcoin alphabet, but valid in this one.
+	withZero := NewAlphabet("0" + btcDigits[1:])
+	s := "abc0def"
+
+	diag := DiagnoseAlphabet(s, []*Alphabet{BTCAlphabet, FlickrAlphabet, withZero})
+	if len(diag) != 3 {
+		t.Fatalf("expected 3 candidates, got %d", len(diag))
+	}
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		if bad := diag[alph]; len(bad) != 1 || bad[0] != 3 {
+			t.Errorf("expected index 3 to be invalid, got %v", bad)
+		}
+	}
+	if bad := diag[withZero]; bad == nil || len(bad) != 0 {
+		t.Errorf("expected no invalid indices, got %v", bad)
+	}
+}
@@ -0,0 +1,53 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"strings"
+)
+
+// maxFingerprintChars is the number of uniformly distributed base58 digits
+// available from a SHA256 digest: 2^256 lies between 58^43 and 58^44.
+const maxFingerprintChars = 43
+
+// Fingerprint returns a human-readable fingerprint of data: the SHA256
+// digest of data encoded with the passed alphabet, formatted as groups
+// space-separated groups of groupLen characters, e.g. "4Fnq jCtZ 7Bqm a2Wx".
+//
+// Every character carries about 5.86 bits of the digest, so a fingerprint
+// of n characters resists accidental collisions up to roughly 2^(2.93*n)
+// fingerprinted values (about 2^47 for 4 groups of 4) and deliberate second
+// preimages up to 2^(5.86*n) attempts.
+//
+// It panics if groups or groupLen is not positive, or if more than 43
+// characters in total are requested.
+func Fingerprint(data []byte, groups, groupLen int, alph *Alphabet) string {
+	total := groups * groupLen
+	if groups <= 0 || groupLen <= 0 || total > maxFingerprintChars {
+		panic("base58 fingerprints must have between 1 and 43 characters")
+	}
+
+	sum := sha256.Sum256(data)
+	enc := _FastBase58EncodingAlphabetBytes(sum[:], alph)
+	// Use the least significant digits, which are close to uniformly
+	// distributed, left-padding with zero digits in the unlikely case of a
+	// short encoding.
+	digits := make([]byte, maxFingerprintChars)
+	for i := range digits {
+		digits[i] = alph.encode[0]
+	}
+	if len(enc) > maxFingerprintChars {
+		enc = enc[len(enc)-maxFingerprintChars:]
+	}
+	copy(digits[maxFingerprintChars-len(enc):], enc)
+	digits = digits[maxFingerprintChars-total:]
+
+	var sb strings.Builder
+	sb.Grow(total + groups - 1)
+	for i := 0; i < groups; i++ {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.Write(digits[i*groupLen : (i+1)*groupLen])
+	}
+	return sb.String()
+}
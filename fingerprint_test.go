@@ -0,0 +1,45 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	data := []byte("hello world")
+	fp := Fingerprint(data, 4, 4, BTCAlphabet)
+	if fp != Fingerprint(data, 4, 4, BTCAlphabet) {
+		t.Errorf("expected a deterministic fingerprint")
+	}
+	if len(fp) != 19 || strings.Count(fp, " ") != 3 {
+		t.Errorf("expected 4 groups of 4 characters, got %q", fp)
+	}
+	for _, g := range strings.Split(fp, " ") {
+		if len(g) != 4 {
+			t.Errorf("expected a group of 4 characters, got %q", g)
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	enc := FastBase58Encoding(sum[:])
+	if want := enc[len(enc)-16:]; strings.Replace(fp, " ", "", -1) != want {
+		t.Errorf("expected fingerprint digits %s, got %s", want, fp)
+	}
+
+	if Fingerprint([]byte("hello world!"), 4, 4, BTCAlphabet) == fp {
+		t.Errorf("expected different data to have a different fingerprint")
+	}
+	if fp := Fingerprint(data, 1, 43, BTCAlphabet); len(fp) != 43 {
+		t.Errorf("expected 43 characters, got %q", fp)
+	}
+}
+
+func TestFingerprintTooLong(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic on too many characters")
+		}
+	}()
+	Fingerprint(nil, 11, 4, BTCAlphabet)
+}
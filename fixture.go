@@ -0,0 +1,77 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+)
+
+// Fixture is a test vector: the hex encoding of some bytes, their base58
+// encoding and the 58 characters of the alphabet used.
+type Fixture struct {
+	Hex      string `json:"hex"`
+	Base58   string `json:"base58"`
+	Alphabet string `json:"alphabet"`
+}
+
+// WriteFixtures writes the passed fixtures to w as newline-delimited JSON.
+func WriteFixtures(w io.Writer, pairs []Fixture) error {
+	enc := json.NewEncoder(w)
+	for _, f := range pairs {
+		if err := enc.Encode(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadFixtures reads newline-delimited JSON fixtures, as written by
+// WriteFixtures, from r until EOF.
+func ReadFixtures(r io.Reader) ([]Fixture, error) {
+	var fixtures []Fixture
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var f Fixture
+		if err := dec.Decode(&f); err == io.EOF {
+			return fixtures, nil
+		} else if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, f)
+	}
+}
+
+// GenerateVectors returns deterministic fixtures, encoded with the passed
+// alphabet, for every input length from 1 to maxBytes and the following bit
+// patterns: all zeros, all 0xFF, a single set bit at each bit position, and
+// alternating 0x00/0xFF and 0xAA/0x55 bytes.
+func GenerateVectors(maxBytes int, alph *Alphabet) []Fixture {
+	var fixtures []Fixture
+	add := func(b []byte) {
+		fixtures = append(fixtures, Fixture{
+			Hex:      hex.EncodeToString(b),
+			Base58:   FastBase58EncodingAlphabet(b, alph),
+			Alphabet: alph.String(),
+		})
+	}
+
+	for n := 1; n <= maxBytes; n++ {
+		add(make([]byte, n))
+		add(bytes.Repeat([]byte{0xFF}, n))
+		for bit := 0; bit < 8*n; bit++ {
+			b := make([]byte, n)
+			b[bit/8] = 0x80 >> uint(bit%8)
+			add(b)
+		}
+		for _, pair := range [][2]byte{{0x00, 0xFF}, {0xFF, 0x00}, {0xAA, 0x55}} {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = pair[i%2]
+			}
+			add(b)
+		}
+	}
+	return fixtures
+}
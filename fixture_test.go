@@ -0,0 +1,85 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFixturesRoundTrip(t *testing.T) {
+	var fixtures []Fixture
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet} {
+		for i := 0; i < 10; i++ {
+			b := make([]byte, i*4)
+			rand.Read(b)
+			fixtures = append(fixtures, Fixture{
+				Hex:      hex.EncodeToString(b),
+				Base58:   FastBase58EncodingAlphabet(b, alph),
+				Alphabet: alph.String(),
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteFixtures(&buf, fixtures); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != len(fixtures) {
+		t.Errorf("expected %d lines, got %d", len(fixtures), lines)
+	}
+
+	read, err := ReadFixtures(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(read, fixtures) {
+		t.Errorf("expected fixtures to round-trip")
+	}
+	for _, f := range read {
+		alph := NewAlphabet(f.Alphabet)
+		dec, _ := hex.DecodeString(f.Hex)
+		if enc := FastBase58EncodingAlphabet(dec, alph); enc != f.Base58 {
+			t.Errorf("expected %s for %s, got %s", f.Base58, f.Hex, enc)
+		}
+	}
+}
+
+func TestReadFixturesInvalid(t *testing.T) {
+	if _, err := ReadFixtures(strings.NewReader(`{"hex":"61","base58":"2g"}` + "\n{")); err == nil {
+		t.Errorf("expected error on malformed JSON")
+	}
+	if fixtures, err := ReadFixtures(strings.NewReader("")); err != nil || len(fixtures) != 0 {
+		t.Errorf("expected no fixtures, got %v (%v)", fixtures, err)
+	}
+}
+
+func TestGenerateVectors(t *testing.T) {
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, randAlphabet()} {
+		vectors := GenerateVectors(32, alph)
+		// per length n: zeros, ones, 8n single bits and 3 alternating patterns
+		if want := 32*5 + 8*32*33/2; len(vectors) != want {
+			t.Errorf("expected %d vectors, got %d", want, len(vectors))
+		}
+		for _, v := range vectors {
+			b, _ := hex.DecodeString(v.Hex)
+			if te := TrivialBase58EncodingAlphabet(b, alph); te != v.Base58 {
+				t.Errorf("encoding err for %s: %s != %s", v.Hex, v.Base58, te)
+			}
+			fd, err := FastBase58DecodingAlphabet(v.Base58, alph)
+			if err != nil || !bytes.Equal(fd, b) {
+				t.Errorf("fast decoding err for %s: %x (%v)", v.Base58, fd, err)
+			}
+			td, err := TrivialBase58DecodingAlphabet(v.Base58, alph)
+			if err != nil || !bytes.Equal(td, b) {
+				t.Errorf("trivial decoding err for %s: %x (%v)", v.Base58, td, err)
+			}
+		}
+	}
+
+	if a, b := GenerateVectors(4, BTCAlphabet), GenerateVectors(4, BTCAlphabet); !reflect.DeepEqual(a, b) {
+		t.Errorf("expected deterministic vectors")
+	}
+}
@@ -0,0 +1,36 @@
+package base58
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// forgivingCutset lists the wrapper and trailing punctuation characters
+// stripped by DecodeForgiving, in addition to whitespace.
+const forgivingCutset = "\"'`<>()[]{},;.:"
+
+// DecodeForgiving decodes the base58 encoded string using the passed alphabet.
+// If s does not decode as given, surrounding whitespace and any of the
+// characters " ' ` < > ( ) [ ] { } , ; . : are trimmed from both ends, as
+// commonly left over when copying a key from quotes, markup or a list.
+// Characters that are digits of the alphabet are never trimmed.
+//
+// If the trimmed string still fails to decode, the error from decoding s as
+// given is returned, so that it refers to the original input.
+func DecodeForgiving(s string, alph *Alphabet) ([]byte, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err == nil {
+		return b, nil
+	}
+	trimmed := strings.TrimFunc(s, func(r rune) bool {
+		if r < utf8.RuneSelf && alph.validDigit(byte(r)) {
+			return false
+		}
+		return strings.ContainsRune(forgivingCutset, r) || unicode.IsSpace(r)
+	})
+	if b, terr := FastBase58DecodingAlphabet(trimmed, alph); terr == nil {
+		return b, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,50 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeForgiving(t *testing.T) {
+	key := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	want, _ := FastBase58Decoding(key)
+
+	for _, s := range []string{
+		key,
+		`"` + key + `"`,
+		"'" + key + "',",
+		"`" + key + "`",
+		"<" + key + ">",
+		"  " + key + ",\n",
+		`["` + key + `"],`,
+	} {
+		dec, err := DecodeForgiving(s, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", s, err)
+			continue
+		}
+		if !bytes.Equal(dec, want) {
+			t.Errorf("expected %x for %q, got %x", want, s, dec)
+		}
+	}
+
+	_, err := DecodeForgiving(`"Toke0kegQ"`, BTCAlphabet)
+	_, origErr := FastBase58Decoding(`"Toke0kegQ"`)
+	if err == nil || err.Error() != origErr.Error() {
+		t.Errorf("expected the original error %v, got %v", origErr, err)
+	}
+}
+
+func TestDecodeForgivingAlphabetPunctuation(t *testing.T) {
+	alph := NewAlphabet(btcDigits[:57] + ",")
+	want, err := FastBase58DecodingAlphabet("abc,", alph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"abc,", `"abc,"`, " abc,;\n"} {
+		dec, err := DecodeForgiving(s, alph)
+		if err != nil || !bytes.Equal(dec, want) {
+			t.Errorf("expected %x for %q, got %x (%v)", want, s, dec, err)
+		}
+	}
+}
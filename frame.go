@@ -0,0 +1,63 @@
+package base58
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EncodeFrame encodes multiple values into a single base58 string with the
+// passed alphabet. Each value is written as its length as an unsigned varint
+// followed by its bytes, and the concatenation is encoded.
+func EncodeFrame(values [][]byte, alph *Alphabet) string {
+	var buf []byte
+	for _, v := range values {
+		buf = appendLengthPrefixed(buf, v)
+	}
+	return FastBase58EncodingAlphabet(buf, alph)
+}
+
+// DecodeFrame decodes a string produced by EncodeFrame and returns its
+// values. An empty string is an empty frame.
+func DecodeFrame(s string, alph *Alphabet) ([][]byte, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+
+	var values [][]byte
+	for len(buf) > 0 {
+		var v []byte
+		v, buf, err = readLengthPrefixed(buf)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// appendLengthPrefixed appends b to dst, prefixed by its length as an
+// unsigned varint.
+func appendLengthPrefixed(dst, b []byte) []byte {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(b)))
+	dst = append(dst, lenBuf[:n]...)
+	return append(dst, b...)
+}
+
+// readLengthPrefixed reads a varint length prefixed value from the start of
+// buf and returns it along with the remainder of buf.
+func readLengthPrefixed(buf []byte) ([]byte, []byte, error) {
+	l, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return nil, nil, fmt.Errorf("invalid length prefix")
+	}
+	buf = buf[n:]
+	if l > uint64(len(buf)) {
+		return nil, nil, fmt.Errorf("length prefix %d exceeds remaining %d bytes", l, len(buf))
+	}
+	return buf[:l:l], buf[l:], nil
+}
@@ -0,0 +1,48 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	testCases := [][][]byte{
+		{},
+		{[]byte("single")},
+		{{}, {0, 0}, []byte("medium value"), bytes.Repeat([]byte{0xAB}, 300), {}},
+	}
+	for _, values := range testCases {
+		s := EncodeFrame(values, BTCAlphabet)
+		dec, err := DecodeFrame(s, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", s, err)
+			continue
+		}
+		if len(dec) != len(values) {
+			t.Errorf("expected %d values, got %d", len(values), len(dec))
+			continue
+		}
+		for i := range values {
+			if !bytes.Equal(dec[i], values[i]) {
+				t.Errorf("value %d: expected %x, got %x", i, values[i], dec[i])
+			}
+		}
+	}
+}
+
+func TestDecodeFrameInvalid(t *testing.T) {
+	testCases := [][]byte{
+		{3, 'a', 'b'},
+		{0x80},
+		{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01},
+		{1, 'a', 0xFF, 0x01},
+	}
+	for _, buf := range testCases {
+		if _, err := DecodeFrame(FastBase58Encoding(buf), BTCAlphabet); err == nil {
+			t.Errorf("expected error for frame %x", buf)
+		}
+	}
+	if _, err := DecodeFrame("0OIl", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid base58")
+	}
+}
@@ -0,0 +1,46 @@
+package base58
+
+// IncrementalDecoder accumulates a base58 encoded string delivered in chunks,
+// validating each character as it arrives, and decodes it once complete.
+// It implements io.Writer.
+type IncrementalDecoder struct {
+	alph *Alphabet
+	buf  []byte
+	n    int
+	err  error
+}
+
+// NewIncrementalDecoder returns an IncrementalDecoder for the passed
+// alphabet.
+func NewIncrementalDecoder(alph *Alphabet) *IncrementalDecoder {
+	return &IncrementalDecoder{alph: alph}
+}
+
+// Write appends chunk to the accumulated string. It returns an error as soon
+// as an invalid character is seen, in which case only the characters before
+// it are accepted; the error is then returned by every later call to Write
+// and Finish.
+func (d *IncrementalDecoder) Write(chunk []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	for i, c := range chunk {
+		if !d.alph.validDigit(c) {
+			d.buf = append(d.buf, chunk[:i]...)
+			d.err = digitError(c, d.n+i)
+			d.n += i
+			return i, d.err
+		}
+	}
+	d.buf = append(d.buf, chunk...)
+	d.n += len(chunk)
+	return len(chunk), nil
+}
+
+// Finish decodes the accumulated string.
+func (d *IncrementalDecoder) Finish() ([]byte, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return FastBase58DecodingAlphabet(string(d.buf), d.alph)
+}
@@ -0,0 +1,46 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestIncrementalDecoder(t *testing.T) {
+	s := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	want, _ := FastBase58Decoding(s)
+
+	d := NewIncrementalDecoder(BTCAlphabet)
+	for _, chunk := range []string{"Toke", "", "nkegQfeZyiNwAJbNbGKP", "FXCWuBvf9Ss623VQ5D", "A"} {
+		if n, err := d.Write([]byte(chunk)); err != nil || n != len(chunk) {
+			t.Fatalf("unexpected result writing %q: %d, %v", chunk, n, err)
+		}
+	}
+	dec, err := d.Finish()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(dec, want) {
+		t.Errorf("expected %x, got %x", want, dec)
+	}
+}
+
+func TestIncrementalDecoderInvalid(t *testing.T) {
+	d := NewIncrementalDecoder(BTCAlphabet)
+	if _, err := d.Write([]byte("Token")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	n, err := d.Write([]byte("ke0gQ"))
+	if err == nil || n != 2 {
+		t.Errorf("expected an error after 2 bytes, got %d, %v", n, err)
+	}
+	if _, err := d.Write([]byte("fe")); err == nil {
+		t.Errorf("expected the error to be sticky")
+	}
+	if _, err := d.Finish(); err == nil {
+		t.Errorf("expected Finish to report the error")
+	}
+
+	if _, err := NewIncrementalDecoder(BTCAlphabet).Finish(); err == nil {
+		t.Errorf("expected an error for an empty string")
+	}
+}
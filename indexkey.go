@@ -0,0 +1,19 @@
+package base58
+
+import "hash/fnv"
+
+// DecodeToIndexKey decodes the base58 encoded string using the passed
+// alphabet and returns the 64-bit FNV-1a hash of the decoded bytes, for use
+// as a compact first-level index key.
+//
+// The hash is not cryptographic and distinct values may collide, so callers
+// must compare the full values on a match.
+func DecodeToIndexKey(s string, alph *Alphabet) (uint64, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return 0, err
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64(), nil
+}
@@ -0,0 +1,24 @@
+package base58
+
+import "testing"
+
+func TestDecodeToIndexKey(t *testing.T) {
+	a, err := DecodeToIndexKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b, _ := DecodeToIndexKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", BTCAlphabet); a != b {
+		t.Errorf("expected the same key, got %x and %x", a, b)
+	}
+	if b, _ := DecodeToIndexKey("ComputeBudget111111111111111111111111111111", BTCAlphabet); a == b {
+		t.Errorf("expected different keys, got %x", a)
+	}
+
+	// FNV-1a of the single byte 0x00
+	if k, _ := DecodeToIndexKey("1", BTCAlphabet); k != 0xaf63bd4c8601b7df {
+		t.Errorf("expected af63bd4c8601b7df, got %x", k)
+	}
+	if _, err := DecodeToIndexKey("0OIl", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+}
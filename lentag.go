@@ -0,0 +1,39 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooLong is returned by EncodeLenTagged when the input is longer than
+// 255 bytes.
+var ErrTooLong = errors.New("input is too long to be length tagged")
+
+// EncodeLenTagged encodes the passed bytes with the passed alphabet, prefixed
+// by a single byte holding their length. It returns ErrTooLong if src is
+// longer than 255 bytes.
+func EncodeLenTagged(src []byte, alph *Alphabet) (string, error) {
+	if len(src) > 255 {
+		return "", ErrTooLong
+	}
+	buf := make([]byte, 1+len(src))
+	buf[0] = byte(len(src))
+	copy(buf[1:], src)
+	return FastBase58EncodingAlphabet(buf, alph), nil
+}
+
+// DecodeLenTagged decodes a string produced by EncodeLenTagged and returns
+// the tagged bytes. It returns an error if the number of bytes following the
+// length byte does not match it, e.g. because the string was truncated.
+// Since truncating a base58 string changes every decoded byte, a damaged
+// string still carries a matching tag by chance about once in 256 times.
+func DecodeLenTagged(s string, alph *Alphabet) ([]byte, error) {
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 || int(buf[0]) != len(buf)-1 {
+		return nil, fmt.Errorf("length tag does not match the %d decoded bytes", len(buf))
+	}
+	return buf[1:], nil
+}
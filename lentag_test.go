@@ -0,0 +1,39 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLenTaggedRoundTrip(t *testing.T) {
+	for _, src := range [][]byte{{}, {0, 0}, []byte("tagged"), bytes.Repeat([]byte{0xFF}, 255)} {
+		s, err := EncodeLenTagged(src, BTCAlphabet)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		dec, err := DecodeLenTagged(s, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", s, err)
+			continue
+		}
+		if !bytes.Equal(dec, src) {
+			t.Errorf("expected %x, got %x", src, dec)
+		}
+	}
+
+	if _, err := EncodeLenTagged(make([]byte, 256), BTCAlphabet); err != ErrTooLong {
+		t.Errorf("expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestLenTaggedTruncation(t *testing.T) {
+	s, _ := EncodeLenTagged(bytes.Repeat([]byte{0xAB}, 32), BTCAlphabet)
+	for _, n := range []int{len(s) - 1, len(s) - 2, len(s) - 8, len(s) / 2, 1} {
+		if _, err := DecodeLenTagged(s[:n], BTCAlphabet); err == nil {
+			t.Errorf("expected truncation to %d characters to be detected", n)
+		}
+	}
+	if _, err := DecodeLenTagged(s+"2", BTCAlphabet); err == nil {
+		t.Errorf("expected an extra character to be detected")
+	}
+}
@@ -0,0 +1,50 @@
+package base58
+
+import "fmt"
+
+// EncodeMigratable encodes the passed payload with the passed alphabet,
+// prefixed by a schema version byte so that future decoders can dispatch on
+// the format.
+func EncodeMigratable(schemaVersion uint8, payload []byte, alph *Alphabet) string {
+	buf := make([]byte, 1+len(payload))
+	buf[0] = schemaVersion
+	copy(buf[1:], payload)
+	return FastBase58EncodingAlphabet(buf, alph)
+}
+
+// SchemaVersion returns the schema version byte of a string produced by
+// EncodeMigratable, without interpreting the rest of the payload.
+//
+// Every character is checked against the alphabet first. A leading zero
+// digit then always means version 0 and is answered without decoding.
+// Otherwise the whole string has to be decoded, since in base58 the value of
+// the first byte depends on every digit.
+func SchemaVersion(s string, alph *Alphabet) (uint8, error) {
+	if len(s) == 0 {
+		return 0, fmt.Errorf("zero length string")
+	}
+	if err := checkDigits(s, alph); err != nil {
+		return 0, err
+	}
+	if s[0] == alph.encode[0] {
+		return 0, nil
+	}
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
+
+// DecodeMigratable decodes a string produced by EncodeMigratable and returns
+// its schema version and payload.
+func DecodeMigratable(s string, alph *Alphabet) (schemaVersion uint8, payload []byte, err error) {
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(buf) == 0 {
+		return 0, nil, fmt.Errorf("missing schema version")
+	}
+	return buf[0], buf[1:], nil
+}
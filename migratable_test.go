@@ -0,0 +1,55 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestMigratable(t *testing.T) {
+	// version 1 stores a big-endian uint32, version 2 a uint64
+	values := []string{
+		EncodeMigratable(1, []byte{0, 0, 0, 42}, BTCAlphabet),
+		EncodeMigratable(2, []byte{0, 0, 0, 0, 0, 0, 0, 42}, BTCAlphabet),
+	}
+	for i, s := range values {
+		v, err := SchemaVersion(s, BTCAlphabet)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if int(v) != i+1 {
+			t.Errorf("expected version %d, got %d", i+1, v)
+		}
+
+		_, payload, err := DecodeMigratable(s, BTCAlphabet)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var n uint64
+		switch v {
+		case 1:
+			n = uint64(binary.BigEndian.Uint32(payload))
+		case 2:
+			n = binary.BigEndian.Uint64(payload)
+		}
+		if n != 42 {
+			t.Errorf("version %d: expected 42, got %d", v, n)
+		}
+	}
+}
+
+func TestSchemaVersionZero(t *testing.T) {
+	s := EncodeMigratable(0, []byte("legacy"), BTCAlphabet)
+	if v, err := SchemaVersion(s, BTCAlphabet); err != nil || v != 0 {
+		t.Errorf("expected version 0, got %d (%v)", v, err)
+	}
+	v, payload, err := DecodeMigratable(s, BTCAlphabet)
+	if err != nil || v != 0 || !bytes.Equal(payload, []byte("legacy")) {
+		t.Errorf("expected version 0 and legacy, got %d, %q (%v)", v, payload, err)
+	}
+	for _, s := range []string{"", "0", "1!!", "11\xFF", "2l"} {
+		if _, err := SchemaVersion(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error on invalid input %q", s)
+		}
+	}
+}
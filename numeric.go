@@ -0,0 +1,170 @@
+package base58
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// DecodeBitLen decodes the base58 encoded string using the given alphabet and
+// returns the number of significant bits of the decoded big-endian value.
+// Leading zero bytes are ignored, so an all-zero value has a bit length of 0.
+func DecodeBitLen(s string, alph *Alphabet) (int, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return 0, err
+	}
+	for i, c := range b {
+		if c != 0 {
+			return (len(b)-i-1)*8 + bits.Len8(c), nil
+		}
+	}
+	return 0, nil
+}
+
+// EncodeCounter encodes n as a base58 number with the passed alphabet.
+//
+// The result is the minimal-length representation of n: there is no
+// leading-zero padding, so 0 encodes as the single zero digit and every other
+// value starts with a non-zero digit. Larger values never produce shorter
+// strings, which makes it suitable for generating short IDs from a counter.
+func EncodeCounter(n uint64, alph *Alphabet) string {
+	var buf [11]byte // 58^11 > 2^64
+	i := len(buf)
+	for {
+		i--
+		buf[i] = alph.encode[n%58]
+		n /= 58
+		if n == 0 {
+			break
+		}
+	}
+	return string(buf[i:])
+}
+
+// DecodeCounter decodes a string produced by EncodeCounter using the passed
+// alphabet. It rejects non-minimal encodings (leading zero digits) and values
+// that overflow a uint64.
+func DecodeCounter(s string, alph *Alphabet) (uint64, error) {
+	if len(s) == 0 {
+		return 0, fmt.Errorf("zero length string")
+	}
+	if len(s) > 1 && s[0] == alph.encode[0] {
+		return 0, fmt.Errorf("non-minimal counter encoding")
+	}
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !alph.validDigit(c) {
+			return 0, digitError(c, i)
+		}
+		d := uint64(alph.decode[c])
+		if n > (math.MaxUint64-d)/58 {
+			return 0, fmt.Errorf("counter overflows uint64")
+		}
+		n = n*58 + d
+	}
+	return n, nil
+}
+
+// Base58ToDecimalString decodes the base58 encoded string using the passed
+// alphabet and returns the decoded big-endian value as a base 10 string.
+//
+// The conversion is numeric: leading zero bytes (leading zero digits) do not
+// affect the value and are lost.
+func Base58ToDecimalString(s string, alph *Alphabet) (string, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return "", err
+	}
+	return new(big.Int).SetBytes(b).String(), nil
+}
+
+// DecimalStringToBase58 parses dec as a non-negative base 10 number and
+// returns its minimal base58 encoding with the passed alphabet. Zero is
+// encoded as the single zero digit. dec must consist of digits only; a
+// leading sign is rejected.
+func DecimalStringToBase58(dec string, alph *Alphabet) (string, error) {
+	if len(dec) > 0 && dec[0] == '-' {
+		return "", fmt.Errorf("negative decimal number (%q)", dec)
+	}
+	n, ok := new(big.Int).SetString(dec, 10)
+	if !ok || dec[0] == '+' {
+		return "", fmt.Errorf("invalid decimal number (%q)", dec)
+	}
+	if n.Sign() == 0 {
+		return string(alph.encode[0]), nil
+	}
+	return FastBase58EncodingAlphabet(n.Bytes(), alph), nil
+}
+
+// Increment treats s as a base58 number in the passed alphabet and returns
+// the encoding of that number plus one.
+//
+// The width of s is preserved when possible: a carry out of the most
+// significant digit only adds a new leading digit if s has no leading zero
+// digit to absorb it. Note that leading zero digits are treated as numeric
+// padding here, so the decoded byte length of the result may differ.
+func Increment(s string, alph *Alphabet) (string, error) {
+	digits, err := digitValues(s, alph)
+	if err != nil {
+		return "", err
+	}
+	i := len(digits) - 1
+	for ; i >= 0 && digits[i] == 57; i-- {
+		digits[i] = 0
+	}
+	if i >= 0 {
+		digits[i]++
+	} else {
+		digits = append([]byte{1}, digits...)
+	}
+	return digitString(digits, alph), nil
+}
+
+// Decrement treats s as a base58 number in the passed alphabet and returns
+// the encoding of that number minus one, preserving the width of s. It
+// returns an error if s encodes zero.
+func Decrement(s string, alph *Alphabet) (string, error) {
+	digits, err := digitValues(s, alph)
+	if err != nil {
+		return "", err
+	}
+	i := len(digits) - 1
+	for ; i >= 0 && digits[i] == 0; i-- {
+	}
+	if i < 0 {
+		return "", fmt.Errorf("cannot decrement zero")
+	}
+	digits[i]--
+	for i++; i < len(digits); i++ {
+		digits[i] = 57
+	}
+	return digitString(digits, alph), nil
+}
+
+// digitValues returns the digit values of the base58 encoded string s.
+func digitValues(s string, alph *Alphabet) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("zero length string")
+	}
+	digits := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !alph.validDigit(c) {
+			return nil, digitError(c, i)
+		}
+		digits[i] = byte(alph.decode[c])
+	}
+	return digits, nil
+}
+
+// digitString encodes the passed digit values, in place, with the passed
+// alphabet.
+func digitString(digits []byte, alph *Alphabet) string {
+	for i, d := range digits {
+		digits[i] = alph.encode[d]
+	}
+	return string(digits)
+}
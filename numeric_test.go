@@ -0,0 +1,162 @@
+package base58
+
+import "testing"
+
+func TestDecodeBitLen(t *testing.T) {
+	testCases := []struct {
+		dec  []byte
+		bits int
+	}{
+		{[]byte{0}, 0},
+		{[]byte{0, 0, 0}, 0},
+		{[]byte{1}, 1},
+		{[]byte{0, 0x80}, 8},
+		{[]byte{0x01, 0x00}, 9},
+		{[]byte{0, 0x7F, 0xFF, 0xFF, 0xFF}, 31},
+	}
+	for _, tc := range testCases {
+		n, err := DecodeBitLen(FastBase58Encoding(tc.dec), BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %x: %v", tc.dec, err)
+			continue
+		}
+		if n != tc.bits {
+			t.Errorf("expected %d bits for %x, got %d", tc.bits, tc.dec, n)
+		}
+	}
+
+	if _, err := DecodeBitLen("0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	}
+}
+
+func TestEncodeCounterSequence(t *testing.T) {
+	prev := ""
+	for n := uint64(0); n <= 1000; n++ {
+		enc := EncodeCounter(n, BTCAlphabet)
+		if prev != "" {
+			if len(enc) < len(prev) || (len(enc) == len(prev) && enc <= prev) {
+				t.Errorf("expected %s (%d) to sort after %s", enc, n, prev)
+			}
+		}
+		prev = enc
+
+		dec, err := DecodeCounter(enc, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error decoding %s: %v", enc, err)
+		}
+		if dec != n {
+			t.Errorf("expected %d, got %d", n, dec)
+		}
+	}
+
+	if enc := EncodeCounter(0, BTCAlphabet); enc != "1" {
+		t.Errorf("expected 1, got %s", enc)
+	}
+	if enc := EncodeCounter(57, BTCAlphabet); enc != "z" {
+		t.Errorf("expected z, got %s", enc)
+	}
+	if enc := EncodeCounter(58, BTCAlphabet); enc != "21" {
+		t.Errorf("expected 21, got %s", enc)
+	}
+}
+
+func TestDecodeCounterBounds(t *testing.T) {
+	max := EncodeCounter(^uint64(0), BTCAlphabet)
+	if n, err := DecodeCounter(max, BTCAlphabet); err != nil || n != ^uint64(0) {
+		t.Errorf("expected max uint64, got %d, %v", n, err)
+	}
+	for _, s := range []string{"", "12", "0", "zzzzzzzzzzzz", max + "1"} {
+		if _, err := DecodeCounter(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error decoding %q", s)
+		}
+	}
+}
+
+func TestDecimalStringRoundTrip(t *testing.T) {
+	testCases := []struct {
+		dec string
+		enc string
+	}{
+		{"0", "1"},
+		{"57", "z"},
+		{"58", "21"},
+		{"18446744073709551616", "jpXCZedGfVR"},
+		{"340282366920938463463374607431768211455", "YcVfxkQb6JRzqk5kF2tNLv"},
+	}
+	for _, tc := range testCases {
+		enc, err := DecimalStringToBase58(tc.dec, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", tc.dec, err)
+			continue
+		}
+		if enc != tc.enc {
+			t.Errorf("expected %s for %s, got %s", tc.enc, tc.dec, enc)
+		}
+		dec, err := Base58ToDecimalString(enc, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", enc, err)
+			continue
+		}
+		if dec != tc.dec {
+			t.Errorf("expected %s for %s, got %s", tc.dec, enc, dec)
+		}
+	}
+
+	// leading zero digits carry no numeric value
+	if dec, _ := Base58ToDecimalString("11z", BTCAlphabet); dec != "57" {
+		t.Errorf("expected 57, got %s", dec)
+	}
+	for _, s := range []string{"", "-1", "-0", "+1", "12a", "0x10"} {
+		if _, err := DecimalStringToBase58(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestIncrementDecrement(t *testing.T) {
+	testCases := []struct {
+		s, next string
+	}{
+		{"1", "2"},
+		{"y", "z"},
+		{"z", "21"},
+		{"1z", "21"},
+		{"zz", "211"},
+		{"2z", "31"},
+		{"11zz", "1211"},
+	}
+	for _, tc := range testCases {
+		next, err := Increment(tc.s, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error incrementing %s: %v", tc.s, err)
+			continue
+		}
+		if next != tc.next {
+			t.Errorf("expected %s + 1 = %s, got %s", tc.s, tc.next, next)
+		}
+
+		prev, err := Decrement(next, BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error decrementing %s: %v", next, err)
+			continue
+		}
+		want, _ := Base58ToDecimalString(tc.s, BTCAlphabet)
+		got, _ := Base58ToDecimalString(prev, BTCAlphabet)
+		if got != want {
+			t.Errorf("expected %s - 1 to equal %s, got %s", next, tc.s, prev)
+		}
+	}
+
+	if prev, _ := Decrement("21", BTCAlphabet); prev != "1z" {
+		t.Errorf("expected width to be preserved, got %s", prev)
+	}
+	for _, s := range []string{"1", "111", "", "0"} {
+		if _, err := Decrement(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error decrementing %q", s)
+		}
+	}
+	if _, err := Increment("0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid digit")
+	}
+}
@@ -0,0 +1,49 @@
+package base58
+
+import "fmt"
+
+// EncodeWithParity encodes the passed bytes with the passed alphabet and
+// appends a parity digit: the sum of all digit values modulo 58.
+//
+// The parity digit detects any single mistyped digit, but it cannot locate
+// or correct the error, and it does not detect transposed digits.
+func EncodeWithParity(src []byte, alph *Alphabet) string {
+	enc := _FastBase58EncodingAlphabetBytes(src, alph)
+	return string(append(enc, alph.encode[paritySum(enc, alph)]))
+}
+
+// VerifyParity checks the trailing parity digit of a string produced by
+// EncodeWithParity and, if it matches, returns the decoded payload.
+//
+// It returns an error if s is empty or contains characters outside of the
+// alphabet, and false with a nil payload if the parity digit does not match.
+func VerifyParity(s string, alph *Alphabet) (bool, []byte, error) {
+	if len(s) == 0 {
+		return false, nil, fmt.Errorf("zero length string")
+	}
+	if err := checkDigits(s, alph); err != nil {
+		return false, nil, err
+	}
+
+	body := s[:len(s)-1]
+	if alph.encode[paritySum([]byte(body), alph)] != s[len(s)-1] {
+		return false, nil, nil
+	}
+	if len(body) == 0 {
+		return true, []byte{}, nil
+	}
+	payload, err := FastBase58DecodingAlphabet(body, alph)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, payload, nil
+}
+
+// paritySum returns the sum modulo 58 of the digit values of enc.
+func paritySum(enc []byte, alph *Alphabet) int {
+	sum := 0
+	for _, c := range enc {
+		sum += int(alph.decode[c])
+	}
+	return sum % 58
+}
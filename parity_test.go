@@ -0,0 +1,39 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParity(t *testing.T) {
+	for _, src := range [][]byte{{}, {0}, {0, 0, 1, 2, 3}, []byte("short code")} {
+		s := EncodeWithParity(src, BTCAlphabet)
+		ok, payload, err := VerifyParity(s, BTCAlphabet)
+		if err != nil || !ok {
+			t.Errorf("expected valid parity for %s, got %v (%v)", s, ok, err)
+			continue
+		}
+		if !bytes.Equal(payload, src) {
+			t.Errorf("expected %x, got %x", src, payload)
+		}
+	}
+
+	s := EncodeWithParity([]byte("short code"), BTCAlphabet)
+	for i := 0; i < len(s); i++ {
+		altered := []byte(s)
+		altered[i] = BTCAlphabet.encode[(BTCAlphabet.decode[s[i]]+1)%58]
+		ok, payload, err := VerifyParity(string(altered), BTCAlphabet)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", altered, err)
+		}
+		if ok || payload != nil {
+			t.Errorf("expected altered digit %d of %s to fail parity", i, s)
+		}
+	}
+
+	for _, s := range []string{"", "abc0"} {
+		if _, _, err := VerifyParity(s, BTCAlphabet); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
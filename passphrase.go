@@ -0,0 +1,56 @@
+package base58
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// AlphabetFromPassphrase deterministically derives an alphabet from the
+// passed passphrase: a permutation of the bitcoin alphabet's characters,
+// shuffled (Fisher-Yates) using HMAC-SHA256 keyed by the passphrase as the
+// source of randomness. The same passphrase always yields the same
+// alphabet.
+//
+// This only obscures the digit order; it is not encryption.
+func AlphabetFromPassphrase(passphrase string) *Alphabet {
+	prf := &passphrasePRF{key: []byte(passphrase)}
+	digits := BTCAlphabet.encode
+	for i := len(digits) - 1; i > 0; i-- {
+		j := prf.intn(i + 1)
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return NewAlphabet(string(digits[:]))
+}
+
+// passphrasePRF produces a deterministic stream of bytes from HMAC-SHA256
+// in counter mode.
+type passphrasePRF struct {
+	key     []byte
+	counter uint64
+	buf     []byte
+}
+
+func (p *passphrasePRF) next() byte {
+	if len(p.buf) == 0 {
+		var msg [8]byte
+		binary.BigEndian.PutUint64(msg[:], p.counter)
+		p.counter++
+		mac := hmac.New(sha256.New, p.key)
+		mac.Write(msg[:])
+		p.buf = mac.Sum(nil)
+	}
+	b := p.buf[0]
+	p.buf = p.buf[1:]
+	return b
+}
+
+// intn returns a uniformly distributed integer in [0, n), for n <= 256.
+func (p *passphrasePRF) intn(n int) int {
+	limit := 256 - 256%n
+	for {
+		if b := int(p.next()); b < limit {
+			return b % n
+		}
+	}
+}
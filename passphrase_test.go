@@ -0,0 +1,29 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAlphabetFromPassphrase(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, p := range []string{"", "correct horse battery staple", "hunter2", "hunter3"} {
+		alph := AlphabetFromPassphrase(p)
+		if again := AlphabetFromPassphrase(p); again.String() != alph.String() {
+			t.Errorf("expected the same alphabet for %q, got %s and %s", p, alph, again)
+		}
+		if !alph.IsPermutationOf(BTCAlphabet) {
+			t.Errorf("expected %s to be a permutation of the bitcoin alphabet", alph)
+		}
+		if seen[alph.String()] {
+			t.Errorf("expected a distinct alphabet for %q, got %s", p, alph)
+		}
+		seen[alph.String()] = true
+
+		src := []byte{0, 0, 1, 2, 3, 0xFF}
+		dec, err := FastBase58DecodingAlphabet(FastBase58EncodingAlphabet(src, alph), alph)
+		if err != nil || !bytes.Equal(dec, src) {
+			t.Errorf("expected %x to round-trip, got %x (%v)", src, dec, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package base58
+
+import "sort"
+
+// MinDistinguishingPrefix returns the smallest length L such that the first
+// L characters (or the whole key, if shorter) of every key in keys are
+// distinct, i.e. the length of the shortest unambiguous abbreviation.
+//
+// It returns 0 for fewer than two keys. If keys contains duplicates, no
+// prefix can tell them apart and the length of the longest key is returned.
+func MinDistinguishingPrefix(keys []string) int {
+	if len(keys) < 2 {
+		return 0
+	}
+	sorted := make([]string, len(keys))
+	copy(sorted, keys)
+	sort.Strings(sorted)
+
+	// The longest common prefix between any two keys is found between
+	// neighbours in sorted order.
+	l := 0
+	for i := 1; i < len(sorted); i++ {
+		prev, k := sorted[i-1], sorted[i]
+		if prev == k {
+			return maxKeyLen(sorted)
+		}
+		n := 0
+		for n < len(prev) && n < len(k) && prev[n] == k[n] {
+			n++
+		}
+		if n+1 > l {
+			l = n + 1
+		}
+	}
+	return l
+}
+
+func maxKeyLen(keys []string) int {
+	longest := 0
+	for _, k := range keys {
+		if len(k) > longest {
+			longest = len(k)
+		}
+	}
+	return longest
+}
@@ -0,0 +1,32 @@
+package base58
+
+import "testing"
+
+func TestMinDistinguishingPrefix(t *testing.T) {
+	keys := []string{
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb",
+		"Tokbn5BMcsWeboLqWzF2zZiMCzYDXjSyN8z3PWSzoGT",
+		"ComputeBudget111111111111111111111111111111",
+	}
+	if l := MinDistinguishingPrefix(keys); l != 6 {
+		t.Errorf("expected 6, got %d", l)
+	}
+
+	testCases := []struct {
+		keys []string
+		l    int
+	}{
+		{nil, 0},
+		{[]string{"abc"}, 0},
+		{[]string{"abc", "xyz"}, 1},
+		{[]string{"abc", "abcd"}, 4},
+		{[]string{"abc", "abd", "abc"}, 3},
+		{[]string{"abcdef", "xy", "xy"}, 6},
+	}
+	for _, tc := range testCases {
+		if l := MinDistinguishingPrefix(tc.keys); l != tc.l {
+			t.Errorf("expected %d for %v, got %d", tc.l, tc.keys, l)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package base58
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// pseudonymLen is the number of HMAC-SHA256 bytes kept by EncodePseudonym.
+const pseudonymLen = 16
+
+// EncodePseudonym returns a stable pseudonym for id: the base58 encoding,
+// with the passed alphabet, of the first 16 bytes of the HMAC-SHA256 of id
+// keyed by salt.
+//
+// The same id and salt always produce the same pseudonym. The mapping is
+// one-way: the id cannot be recovered from the pseudonym without the salt
+// and a brute-force search over candidate ids.
+func EncodePseudonym(id []byte, salt []byte, alph *Alphabet) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(id)
+	return FastBase58EncodingAlphabet(mac.Sum(nil)[:pseudonymLen], alph)
+}
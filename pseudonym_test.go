@@ -0,0 +1,22 @@
+package base58
+
+import "testing"
+
+func TestEncodePseudonym(t *testing.T) {
+	id := []byte("user-1234")
+	a := EncodePseudonym(id, []byte("salt-a"), BTCAlphabet)
+	if b := EncodePseudonym(id, []byte("salt-a"), BTCAlphabet); a != b {
+		t.Errorf("expected the same pseudonym, got %s and %s", a, b)
+	}
+	if b := EncodePseudonym(id, []byte("salt-b"), BTCAlphabet); a == b {
+		t.Errorf("expected different pseudonyms for different salts, got %s", a)
+	}
+	if b := EncodePseudonym([]byte("user-1235"), []byte("salt-a"), BTCAlphabet); a == b {
+		t.Errorf("expected different pseudonyms for different ids, got %s", a)
+	}
+
+	dec, err := FastBase58Decoding(a)
+	if err != nil || len(dec) != pseudonymLen {
+		t.Errorf("expected a %d byte pseudonym, got %x (%v)", pseudonymLen, dec, err)
+	}
+}
@@ -0,0 +1,36 @@
+package base58
+
+import "fmt"
+
+// ErrWrongLength is returned when a decoded value does not have the expected
+// length. Length holds the actual decoded length.
+type ErrWrongLength struct {
+	Length int
+}
+
+func (e *ErrWrongLength) Error() string {
+	return fmt.Sprintf("decoded value has wrong length: %d", e.Length)
+}
+
+// DecodeMultipleOf decodes the base58 encoded string using the passed
+// alphabet and splits the result into records of recordSize bytes.
+//
+// It returns an *ErrWrongLength error if the decoded length is not a nonzero
+// multiple of recordSize.
+func DecodeMultipleOf(s string, recordSize int, alph *Alphabet) ([][]byte, error) {
+	if recordSize <= 0 {
+		return nil, fmt.Errorf("invalid record size: %d", recordSize)
+	}
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 || len(b)%recordSize != 0 {
+		return nil, &ErrWrongLength{Length: len(b)}
+	}
+	records := make([][]byte, 0, len(b)/recordSize)
+	for i := 0; i < len(b); i += recordSize {
+		records = append(records, b[i:i+recordSize:i+recordSize])
+	}
+	return records, nil
+}
@@ -0,0 +1,36 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeMultipleOf(t *testing.T) {
+	var src []byte
+	for i := 0; i < 3; i++ {
+		src = append(src, bytes.Repeat([]byte{byte(i)}, 8)...)
+	}
+	records, err := DecodeMultipleOf(FastBase58Encoding(src), 8, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+	for i, r := range records {
+		if !bytes.Equal(r, src[i*8:(i+1)*8]) {
+			t.Errorf("record %d: expected %x, got %x", i, src[i*8:(i+1)*8], r)
+		}
+	}
+
+	_, err = DecodeMultipleOf(FastBase58Encoding(src[:23]), 8, BTCAlphabet)
+	if werr, ok := err.(*ErrWrongLength); !ok {
+		t.Errorf("expected *ErrWrongLength, got %v", err)
+	} else if werr.Length != 23 {
+		t.Errorf("expected length 23, got %d", werr.Length)
+	}
+
+	if _, err := DecodeMultipleOf("1", 0, BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid record size")
+	}
+}
@@ -0,0 +1,69 @@
+package base58
+
+import (
+	"fmt"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	byCode     map[byte]*Alphabet
+	byAlphabet map[[58]byte]byte
+}{
+	byCode:     make(map[byte]*Alphabet),
+	byAlphabet: make(map[[58]byte]byte),
+}
+
+func init() {
+	mustRegisterAlphabet('z', BTCAlphabet)
+	mustRegisterAlphabet('Z', FlickrAlphabet)
+	mustRegisterAlphabet('r', RippleAlphabet)
+}
+
+func mustRegisterAlphabet(code byte, a *Alphabet) {
+	if err := RegisterAlphabet(code, a); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterAlphabet associates the passed alphabet with a one character code
+// so that it can be identified by self-describing encodings.
+//
+// The bitcoin, flickr and ripple alphabets are registered by default as 'z',
+// 'Z' and 'r' respectively. It returns an error if the code is not a
+// printable ASCII character, or if either the code or the alphabet is
+// already registered.
+func RegisterAlphabet(code byte, a *Alphabet) error {
+	if code <= ' ' || code > '~' {
+		return fmt.Errorf("alphabet code (%q) is not a printable ascii character", code)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.byCode[code]; ok {
+		return fmt.Errorf("alphabet code (%q) is already registered", code)
+	}
+	if c, ok := registry.byAlphabet[a.encode]; ok {
+		return fmt.Errorf("alphabet is already registered with code (%q)", c)
+	}
+	registry.byCode[code] = a
+	registry.byAlphabet[a.encode] = code
+	return nil
+}
+
+// LookupAlphabet returns the alphabet registered with the passed code.
+func LookupAlphabet(code byte) (*Alphabet, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	a, ok := registry.byCode[code]
+	return a, ok
+}
+
+// alphabetCode returns the code the passed alphabet is registered with.
+func alphabetCode(a *Alphabet) (byte, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	c, ok := registry.byAlphabet[a.encode]
+	return c, ok
+}
@@ -0,0 +1,20 @@
+package base58
+
+// RepairTransposition tries swapping each pair of adjacent characters of s,
+// from left to right, and returns the first variant accepted by verify
+// (for example VerifyCheck). It returns false if no single adjacent swap
+// produces a string that verify accepts.
+func RepairTransposition(s string, verify func(string) bool) (string, bool) {
+	buf := []byte(s)
+	for i := 0; i+1 < len(buf); i++ {
+		if buf[i] == buf[i+1] {
+			continue
+		}
+		buf[i], buf[i+1] = buf[i+1], buf[i]
+		if candidate := string(buf); verify(candidate) {
+			return candidate, true
+		}
+		buf[i], buf[i+1] = buf[i+1], buf[i]
+	}
+	return "", false
+}
@@ -0,0 +1,30 @@
+package base58
+
+import "testing"
+
+func TestRepairTransposition(t *testing.T) {
+	good := "1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq"
+	for i := 0; i+1 < len(good); i++ {
+		if good[i] == good[i+1] {
+			continue
+		}
+		typo := []byte(good)
+		typo[i], typo[i+1] = typo[i+1], typo[i]
+		if VerifyCheck(string(typo)) {
+			t.Fatalf("expected %s to fail the checksum", typo)
+		}
+
+		fixed, ok := RepairTransposition(string(typo), VerifyCheck)
+		if !ok {
+			t.Errorf("expected %s to be repaired", typo)
+			continue
+		}
+		if fixed != good {
+			t.Errorf("expected %s, got %s", good, fixed)
+		}
+	}
+
+	if _, ok := RepairTransposition("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZzzz", VerifyCheck); ok {
+		t.Errorf("expected an unrepairable string to fail")
+	}
+}
@@ -0,0 +1,59 @@
+package base58
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ringScratchLen is the number of decoder limbs kept on the stack by
+// DecodeIntoRing, enough for strings of up to 256 characters.
+const ringScratchLen = 64
+
+// ringScratchPool holds decoder limbs for strings too long for the stack.
+var ringScratchPool = sync.Pool{
+	New: func() interface{} {
+		s := make([]uint32, 0, 4*ringScratchLen)
+		return &s
+	},
+}
+
+// DecodeIntoRing decodes the base58 encoded string using the passed alphabet
+// and writes the result into the ring buffer ring, starting at offset.
+//
+// Bytes are written at ring[offset], ring[offset+1], ... wrapping around to
+// ring[0] after the last element, so the value may be split into a tail and
+// a head segment. written is the decoded length and newOffset is the index
+// just past the last byte written, modulo len(ring); it equals offset when
+// the value fills the ring exactly. Nothing is written if an error is
+// returned.
+//
+// It returns an error if offset is outside of ring or the decoded value is
+// longer than ring. The value is decoded straight into the ring; the
+// decoder's scratch space lives on the stack for strings of up to 256
+// characters and comes from a pool beyond that, so steady-state calls do not
+// allocate.
+func DecodeIntoRing(ring []byte, offset int, s string, alph *Alphabet) (written int, newOffset int, err error) {
+	if offset < 0 || offset >= len(ring) {
+		return 0, offset, fmt.Errorf("ring offset %d out of range [0, %d)", offset, len(ring))
+	}
+
+	var stack [ringScratchLen]uint32
+	scratch := stack[:]
+	if need := (len(s) + 3) / 4; need > len(scratch) {
+		p := ringScratchPool.Get().(*[]uint32)
+		defer ringScratchPool.Put(p)
+		if cap(*p) < need {
+			*p = make([]uint32, need)
+		}
+		scratch = (*p)[:need]
+	}
+
+	n, err := decodeInto(ring[offset:], ring[:offset], scratch, s, alph)
+	if err == errShortDst {
+		return 0, offset, fmt.Errorf("decoded value of %d bytes does not fit in ring of %d bytes", n, len(ring))
+	}
+	if err != nil {
+		return 0, offset, err
+	}
+	return n, (offset + n) % len(ring), nil
+}
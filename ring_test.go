@@ -0,0 +1,75 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeIntoRing(t *testing.T) {
+	ring := make([]byte, 8)
+	src := []byte{1, 2, 3, 4, 5}
+
+	written, off, err := DecodeIntoRing(ring, 6, FastBase58Encoding(src), BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if written != 5 || off != 3 {
+		t.Errorf("expected 5 bytes written and offset 3, got %d and %d", written, off)
+	}
+	if want := []byte{3, 4, 5, 0, 0, 0, 1, 2}; !bytes.Equal(ring, want) {
+		t.Errorf("expected ring %x, got %x", want, ring)
+	}
+
+	full := []byte{9, 9, 9, 9, 9, 9, 9, 9}
+	written, off, err = DecodeIntoRing(ring, off, FastBase58Encoding(full), BTCAlphabet)
+	if err != nil || written != 8 || off != 3 {
+		t.Errorf("expected a full ring write ending at offset 3, got %d, %d, %v", written, off, err)
+	}
+	if !bytes.Equal(ring, full) {
+		t.Errorf("expected ring %x, got %x", full, ring)
+	}
+}
+
+func TestDecodeIntoRingErrors(t *testing.T) {
+	ring := make([]byte, 4)
+	if _, _, err := DecodeIntoRing(ring, 0, FastBase58Encoding([]byte{1, 2, 3, 4, 5}), BTCAlphabet); err == nil {
+		t.Errorf("expected error for value larger than the ring")
+	}
+	if _, _, err := DecodeIntoRing(ring, 4, "2", BTCAlphabet); err == nil {
+		t.Errorf("expected error for offset out of range")
+	}
+	if _, _, err := DecodeIntoRing(ring, 0, "0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid base58")
+	}
+	if !bytes.Equal(ring, make([]byte, 4)) {
+		t.Errorf("expected ring to be untouched, got %x", ring)
+	}
+}
+
+func TestDecodeIntoRingAllocs(t *testing.T) {
+	ring := make([]byte, 256)
+	key := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	long := FastBase58Encoding(bytes.Repeat([]byte{0xAB}, 200))
+	if len(long) <= 4*ringScratchLen {
+		t.Fatalf("expected %s to need pooled scratch space", long)
+	}
+
+	offset := 0
+	for _, s := range []string{key, long} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, offset, _ = DecodeIntoRing(ring, offset, s, BTCAlphabet)
+		})
+		if allocs != 0 {
+			t.Errorf("expected no allocations decoding %d characters, got %v", len(s), allocs)
+		}
+	}
+
+	want := bytes.Repeat([]byte{0xAB}, 200)
+	n, off, err := DecodeIntoRing(ring, 100, long, BTCAlphabet)
+	if err != nil || n != 200 || off != 44 {
+		t.Fatalf("unexpected result: %d, %d, %v", n, off, err)
+	}
+	if got := append(append([]byte{}, ring[100:]...), ring[:44]...); !bytes.Equal(got, want) {
+		t.Errorf("expected the wrapped value to read back, got %x", got)
+	}
+}
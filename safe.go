@@ -0,0 +1,32 @@
+package base58
+
+import "fmt"
+
+// ErrInternalPanic is returned by SafeDecode when decoding panicked. It
+// carries the recovered value. Such a panic indicates a bug in this package.
+type ErrInternalPanic struct {
+	Value interface{}
+}
+
+func (e *ErrInternalPanic) Error() string {
+	return fmt.Sprintf("internal panic while decoding base58: %v", e.Value)
+}
+
+// safeDecodeFunc is the decoder wrapped by SafeDecode; tests override it to
+// inject panics.
+var safeDecodeFunc = FastBase58DecodingAlphabet
+
+// SafeDecode decodes the base58 encoded string using the passed alphabet,
+// like FastBase58DecodingAlphabet, but converts any panic raised while
+// decoding into an *ErrInternalPanic error instead of crashing the caller.
+//
+// It is meant as a defensive boundary for untrusted input; a panic here
+// always indicates a library bug and should be reported.
+func SafeDecode(s string, alph *Alphabet) (result []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, &ErrInternalPanic{Value: r}
+		}
+	}()
+	return safeDecodeFunc(s, alph)
+}
@@ -0,0 +1,42 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSafeDecode(t *testing.T) {
+	dec, err := SafeDecode("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, _ := FastBase58Decoding("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq")
+	if !bytes.Equal(dec, want) {
+		t.Errorf("expected %x, got %x", want, dec)
+	}
+
+	if _, err := SafeDecode("0OIl", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid input")
+	} else if _, ok := err.(*ErrInternalPanic); ok {
+		t.Errorf("expected a regular decoding error, got %v", err)
+	}
+}
+
+func TestSafeDecodeRecoversPanic(t *testing.T) {
+	defer func(f func(string, *Alphabet) ([]byte, error)) { safeDecodeFunc = f }(safeDecodeFunc)
+	safeDecodeFunc = func(string, *Alphabet) ([]byte, error) {
+		panic("injected")
+	}
+
+	dec, err := SafeDecode("1QCaxc8hutpdZ62iKZsn1TCG3nh7uPZojq", BTCAlphabet)
+	if dec != nil {
+		t.Errorf("expected no result, got %x", dec)
+	}
+	perr, ok := err.(*ErrInternalPanic)
+	if !ok {
+		t.Fatalf("expected *ErrInternalPanic, got %v", err)
+	}
+	if perr.Value != "injected" {
+		t.Errorf("expected recovered value %q, got %v", "injected", perr.Value)
+	}
+}
@@ -0,0 +1,31 @@
+package base58
+
+import "fmt"
+
+// EncodeSelfDescribing encodes the passed bytes with the passed alphabet and
+// prefixes the result with the code the alphabet is registered with (see
+// RegisterAlphabet), so that the string carries its own alphabet identity.
+func EncodeSelfDescribing(src []byte, a *Alphabet) (string, error) {
+	code, ok := alphabetCode(a)
+	if !ok {
+		return "", fmt.Errorf("alphabet is not registered")
+	}
+	return string(code) + FastBase58EncodingAlphabet(src, a), nil
+}
+
+// DecodeSelfDescribing decodes a string produced by EncodeSelfDescribing,
+// using the alphabet identified by its first character. A string holding
+// only the code, as produced for empty input, decodes to an empty value.
+func DecodeSelfDescribing(s string) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("zero length string")
+	}
+	a, ok := LookupAlphabet(s[0])
+	if !ok {
+		return nil, fmt.Errorf("unknown alphabet code (%q)", s[0])
+	}
+	if len(s) == 1 {
+		return []byte{}, nil
+	}
+	return FastBase58DecodingAlphabet(s[1:], a)
+}
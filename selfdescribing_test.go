@@ -0,0 +1,60 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSelfDescribingRoundTrip(t *testing.T) {
+	src := []byte{0, 0, 0x12, 0x34, 0x56, 0x78, 0x9a}
+	for _, alph := range []*Alphabet{BTCAlphabet, FlickrAlphabet, RippleAlphabet} {
+		enc, err := EncodeSelfDescribing(src, alph)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if enc[1:] != FastBase58EncodingAlphabet(src, alph) {
+			t.Errorf("expected body %s, got %s", FastBase58EncodingAlphabet(src, alph), enc[1:])
+		}
+		dec, err := DecodeSelfDescribing(enc)
+		if err != nil {
+			t.Fatalf("unexpected error decoding %s: %v", enc, err)
+		}
+		if !bytes.Equal(dec, src) {
+			t.Errorf("expected %x, got %x", src, dec)
+		}
+	}
+
+	enc, _ := EncodeSelfDescribing(src, RippleAlphabet)
+	if enc[0] != 'r' {
+		t.Errorf("expected ripple code 'r', got %q", enc[0])
+	}
+
+	enc, _ = EncodeSelfDescribing(nil, BTCAlphabet)
+	if dec, err := DecodeSelfDescribing(enc); err != nil || len(dec) != 0 {
+		t.Errorf("expected an empty value for %q, got %x (%v)", enc, dec, err)
+	}
+}
+
+func TestSelfDescribingUnknown(t *testing.T) {
+	if _, err := DecodeSelfDescribing("!abc"); err == nil {
+		t.Errorf("expected error on unknown alphabet code")
+	}
+	if _, err := DecodeSelfDescribing(""); err == nil {
+		t.Errorf("expected error on empty string")
+	}
+	if _, err := EncodeSelfDescribing([]byte{1}, randAlphabet()); err == nil {
+		t.Errorf("expected error on unregistered alphabet")
+	}
+}
+
+func TestRegisterAlphabet(t *testing.T) {
+	if err := RegisterAlphabet('z', randAlphabet()); err == nil {
+		t.Errorf("expected error on duplicate code")
+	}
+	if err := RegisterAlphabet('!', NewAlphabet(btcDigits)); err == nil {
+		t.Errorf("expected error on duplicate alphabet")
+	}
+	if err := RegisterAlphabet(' ', randAlphabet()); err == nil {
+		t.Errorf("expected error on non-printable code")
+	}
+}
@@ -0,0 +1,37 @@
+//go:build go1.23
+
+package base58
+
+import (
+	"iter"
+	"strings"
+)
+
+// DecodeSeq returns an iterator over the values of s, separated by sep and
+// each decoded with the passed alphabet. Values are decoded lazily, one per
+// iteration.
+//
+// Every value between separators is decoded, so an empty value, whether
+// leading, trailing or between two adjacent separators, yields an error. If
+// a value fails to decode, its error is yielded with a nil value and
+// iteration stops. An empty s yields nothing; an empty sep treats all of s
+// as a single value.
+func DecodeSeq(s string, sep string, alph *Alphabet) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		if s == "" {
+			return
+		}
+		for rest, more := s, true; more; {
+			tok := rest
+			if sep != "" {
+				tok, rest, more = strings.Cut(rest, sep)
+			} else {
+				more = false
+			}
+			b, err := FastBase58DecodingAlphabet(tok, alph)
+			if !yield(b, err) || err != nil {
+				return
+			}
+		}
+	}
+}
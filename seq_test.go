@@ -0,0 +1,83 @@
+//go:build go1.23
+
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeSeq(t *testing.T) {
+	s := FastBase58Encoding([]byte{1}) + "," + "0OIl" + "," + FastBase58Encoding([]byte{3})
+
+	var values [][]byte
+	var errs []error
+	for b, err := range DecodeSeq(s, ",", BTCAlphabet) {
+		values = append(values, b)
+		errs = append(errs, err)
+	}
+	if len(values) != 2 {
+		t.Fatalf("expected iteration to stop after the invalid value, got %d values", len(values))
+	}
+	if errs[0] != nil || !bytes.Equal(values[0], []byte{1}) {
+		t.Errorf("expected 01, got %x (%v)", values[0], errs[0])
+	}
+	if errs[1] == nil || values[1] != nil {
+		t.Errorf("expected an error for the invalid value, got %x (%v)", values[1], errs[1])
+	}
+}
+
+func TestDecodeSeqAll(t *testing.T) {
+	want := [][]byte{{0, 1}, []byte("two"), {3}}
+	var parts []byte
+	for i, w := range want {
+		if i > 0 {
+			parts = append(parts, " | "...)
+		}
+		parts = append(parts, FastBase58Encoding(w)...)
+	}
+
+	i := 0
+	for b, err := range DecodeSeq(string(parts), " | ", BTCAlphabet) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(b, want[i]) {
+			t.Errorf("value %d: expected %x, got %x", i, want[i], b)
+		}
+		i++
+		if i == 2 {
+			break
+		}
+	}
+	if i != 2 {
+		t.Errorf("expected to stop after 2 values, got %d", i)
+	}
+
+	for range DecodeSeq("", ",", BTCAlphabet) {
+		t.Errorf("expected no values for an empty string")
+	}
+}
+
+func TestDecodeSeqEmptyValues(t *testing.T) {
+	a := FastBase58Encoding([]byte{1})
+	for _, tc := range []struct {
+		s    string
+		want int // values yielded, the last of which is an error
+	}{
+		{"," + a, 1},
+		{a + ",," + a, 2},
+		{a + "," + a + ",", 3},
+		{",", 1},
+	} {
+		n := 0
+		var last error
+		for _, err := range DecodeSeq(tc.s, ",", BTCAlphabet) {
+			n++
+			last = err
+		}
+		if n != tc.want || last == nil {
+			t.Errorf("%q: expected an error as value %d, got %d values ending in %v", tc.s, tc.want, n, last)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package base58
+
+import (
+	"bytes"
+	"sort"
+)
+
+// EncodeSet encodes a set of byte strings into a single base58 string with
+// the passed alphabet.
+//
+// The items are sorted byte-lexicographically and encoded as a frame (see
+// EncodeFrame), so the same set always yields the same string regardless of
+// the order of items. The passed slice is not modified.
+func EncodeSet(items [][]byte, alph *Alphabet) string {
+	sorted := make([][]byte, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	return EncodeFrame(sorted, alph)
+}
+
+// DecodeSet decodes a string produced by EncodeSet and returns its items in
+// sorted order.
+func DecodeSet(s string, alph *Alphabet) ([][]byte, error) {
+	return DecodeFrame(s, alph)
+}
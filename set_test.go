@@ -0,0 +1,53 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeSetOrderIndependent(t *testing.T) {
+	items := [][]byte{
+		[]byte("solana"),
+		{},
+		{0, 0, 1},
+		[]byte("base58"),
+		{0xFF, 0xFE},
+		[]byte("base"),
+	}
+	want := EncodeSet(items, BTCAlphabet)
+
+	for i := 0; i < 20; i++ {
+		shuffled := make([][]byte, len(items))
+		copy(shuffled, items)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		if got := EncodeSet(shuffled, BTCAlphabet); got != want {
+			t.Errorf("expected %s for shuffled set, got %s", want, got)
+		}
+	}
+
+	dec, err := DecodeSet(want, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	expected := [][]byte{{}, {0, 0, 1}, []byte("base"), []byte("base58"), []byte("solana"), {0xFF, 0xFE}}
+	if len(dec) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(dec))
+	}
+	for i := range expected {
+		if !bytes.Equal(dec[i], expected[i]) {
+			t.Errorf("item %d: expected %x, got %x", i, expected[i], dec[i])
+		}
+	}
+}
+
+func TestDecodeSetInvalid(t *testing.T) {
+	if _, err := DecodeSet(FastBase58Encoding([]byte{5, 1, 2}), BTCAlphabet); err == nil {
+		t.Errorf("expected error on truncated item")
+	}
+	if items, err := DecodeSet(EncodeSet(nil, BTCAlphabet), BTCAlphabet); err != nil || len(items) != 0 {
+		t.Errorf("expected empty set, got %v, %v", items, err)
+	}
+}
@@ -0,0 +1,35 @@
+package base58
+
+import "strings"
+
+// EncodeSharded encodes the passed bytes with the passed alphabet and splits
+// the result into chunks of fieldChars characters; the last chunk may be
+// shorter.
+//
+// It panics if fieldChars is not positive.
+func EncodeSharded(src []byte, fieldChars int, alph *Alphabet) []string {
+	if fieldChars <= 0 {
+		panic("base58 shards must be at least one character long")
+	}
+	enc := FastBase58EncodingAlphabet(src, alph)
+	parts := make([]string, 0, (len(enc)+fieldChars-1)/fieldChars)
+	for len(enc) > fieldChars {
+		parts = append(parts, enc[:fieldChars])
+		enc = enc[fieldChars:]
+	}
+	if len(enc) > 0 {
+		parts = append(parts, enc)
+	}
+	return parts
+}
+
+// DecodeSharded concatenates the passed parts and decodes the result using
+// the passed alphabet. No parts, as produced by EncodeSharded for empty
+// input, decode to an empty value.
+func DecodeSharded(parts []string, alph *Alphabet) ([]byte, error) {
+	s := strings.Join(parts, "")
+	if len(s) == 0 {
+		return []byte{}, nil
+	}
+	return FastBase58DecodingAlphabet(s, alph)
+}
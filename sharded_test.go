@@ -0,0 +1,49 @@
+package base58
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestShardedRoundTrip(t *testing.T) {
+	src := bytes.Repeat([]byte{0xFF}, 32)
+	enc := FastBase58Encoding(src)
+	if len(enc) != 44 {
+		t.Fatalf("expected a 44 character encoding, got %s", enc)
+	}
+
+	parts := EncodeSharded(src, 10, BTCAlphabet)
+	if len(parts) != 5 {
+		t.Fatalf("expected 5 parts, got %v", parts)
+	}
+	for i, p := range parts {
+		want := 10
+		if i == 4 {
+			want = 4
+		}
+		if len(p) != want {
+			t.Errorf("part %d: expected %d characters, got %s", i, want, p)
+		}
+	}
+	if strings.Join(parts, "") != enc {
+		t.Errorf("expected parts to join to %s, got %v", enc, parts)
+	}
+
+	dec, err := DecodeSharded(parts, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(dec, src) {
+		t.Errorf("expected %x, got %x", src, dec)
+	}
+
+	if parts := EncodeSharded(src, 44, BTCAlphabet); len(parts) != 1 || parts[0] != enc {
+		t.Errorf("expected a single part, got %v", parts)
+	}
+
+	parts = EncodeSharded(nil, 10, BTCAlphabet)
+	if dec, err := DecodeSharded(parts, BTCAlphabet); err != nil || len(dec) != 0 {
+		t.Errorf("expected an empty value for %v, got %x (%v)", parts, dec, err)
+	}
+}
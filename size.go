@@ -0,0 +1,22 @@
+package base58
+
+// EncodedLen returns the maximum length in characters of the base58 encoding
+// of n bytes.
+func EncodedLen(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	// Same bound as the encoder's buffer: ceil(log(256)/log(58)) per byte.
+	return n*555/406 + 1
+}
+
+// BatchEncodedSize returns the maximum total number of characters needed to
+// encode a batch of inputs, where lengthCounts maps an input length in bytes
+// to the number of inputs of that length.
+func BatchEncodedSize(lengthCounts map[int]int) int {
+	total := 0
+	for n, count := range lengthCounts {
+		total += EncodedLen(n) * count
+	}
+	return total
+}
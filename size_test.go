@@ -0,0 +1,39 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodedLen(t *testing.T) {
+	if n := EncodedLen(0); n != 0 {
+		t.Errorf("expected 0, got %d", n)
+	}
+	for n := 1; n < 256; n++ {
+		if enc := FastBase58Encoding(bytes.Repeat([]byte{0xFF}, n)); len(enc) > EncodedLen(n) {
+			t.Errorf("encoding of %d bytes is %d characters, longer than %d", n, len(enc), EncodedLen(n))
+		}
+		b := make([]byte, n)
+		rand.Read(b)
+		if enc := FastBase58Encoding(b); len(enc) > EncodedLen(n) {
+			t.Errorf("encoding of %d bytes is %d characters, longer than %d", n, len(enc), EncodedLen(n))
+		}
+	}
+}
+
+func TestBatchEncodedSize(t *testing.T) {
+	inputs := []int{32, 32, 32, 64, 25, 0}
+	counts := make(map[int]int)
+	want := 0
+	for _, n := range inputs {
+		counts[n]++
+		want += EncodedLen(n)
+	}
+	if got := BatchEncodedSize(counts); got != want {
+		t.Errorf("expected %d, got %d", want, got)
+	}
+	if got := BatchEncodedSize(nil); got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+}
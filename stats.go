@@ -0,0 +1,51 @@
+package base58
+
+import "math"
+
+// UsedCharacters returns the distinct characters of the passed alphabet that
+// appear in s, sorted in ascending byte order. It returns an error if s
+// contains a character outside of the alphabet.
+func UsedCharacters(s string, alph *Alphabet) ([]byte, error) {
+	var seen [128]bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !alph.validDigit(c) {
+			return nil, digitError(c, i)
+		}
+		seen[c] = true
+	}
+
+	var used []byte
+	for c, ok := range seen {
+		if ok {
+			used = append(used, byte(c))
+		}
+	}
+	return used, nil
+}
+
+// ShannonEntropy returns the Shannon entropy, in bits per character, of the
+// character distribution of s. A random string over the full alphabet
+// approaches log2(58) (about 5.86) bits per character as it grows; values far
+// below that suggest structured, non-random data. It returns an error if s
+// contains a character outside of the alphabet.
+func ShannonEntropy(s string, alph *Alphabet) (float64, error) {
+	var counts [58]int
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !alph.validDigit(c) {
+			return 0, digitError(c, i)
+		}
+		counts[alph.decode[c]]++
+	}
+
+	var h float64
+	n := float64(len(s))
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / n
+			h -= p * math.Log2(p)
+		}
+	}
+	return h, nil
+}
@@ -0,0 +1,44 @@
+package base58
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUsedCharacters(t *testing.T) {
+	used, err := UsedCharacters("zTokenkeg111T", BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(used) != "1Tegknoz" {
+		t.Errorf("expected 1Tegknoz, got %s", used)
+	}
+
+	if used, err := UsedCharacters("", BTCAlphabet); err != nil || len(used) != 0 {
+		t.Errorf("expected no characters, got %q (%v)", used, err)
+	}
+	if _, err := UsedCharacters("abc0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid character")
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	h, err := ShannonEntropy(strings.Repeat("z", 64), BTCAlphabet)
+	if err != nil || h != 0 {
+		t.Errorf("expected zero entropy, got %v (%v)", h, err)
+	}
+
+	// every character of the alphabet exactly once
+	h, err = ShannonEntropy(btcDigits, BTCAlphabet)
+	if err != nil || math.Abs(h-math.Log2(58)) > 1e-9 {
+		t.Errorf("expected %v bits, got %v (%v)", math.Log2(58), h, err)
+	}
+
+	if h, err := ShannonEntropy("", BTCAlphabet); err != nil || h != 0 {
+		t.Errorf("expected zero entropy for empty string, got %v (%v)", h, err)
+	}
+	if _, err := ShannonEntropy("abc0", BTCAlphabet); err == nil {
+		t.Errorf("expected error on invalid character")
+	}
+}
@@ -0,0 +1,41 @@
+package base58
+
+import "hash/crc32"
+
+// textChecksumLen is the number of base58 digits of a text checksum suffix;
+// 58^6 > 2^32.
+const textChecksumLen = 6
+
+// TextChecksum returns the IEEE CRC-32 of the characters of s.
+//
+// Unlike Base58Check, which checksums the decoded bytes, this protects the
+// textual form itself, for systems where the string is the unit of
+// transport.
+func TextChecksum(s string) uint32 {
+	return crc32.ChecksumIEEE([]byte(s))
+}
+
+// AppendTextChecksum returns s followed by its TextChecksum, written as 6
+// zero-padded base58 digits of the bitcoin alphabet.
+func AppendTextChecksum(s string) string {
+	var suffix [textChecksumLen]byte
+	sum := TextChecksum(s)
+	for i := textChecksumLen - 1; i >= 0; i-- {
+		suffix[i] = BTCAlphabet.encode[sum%58]
+		sum /= 58
+	}
+	return s + string(suffix[:])
+}
+
+// VerifyTextChecksum checks the suffix added by AppendTextChecksum and
+// returns the original string without it, and whether the checksum matched.
+func VerifyTextChecksum(s string) (string, bool) {
+	if len(s) < textChecksumLen {
+		return "", false
+	}
+	text := s[:len(s)-textChecksumLen]
+	if AppendTextChecksum(text) != s {
+		return "", false
+	}
+	return text, true
+}
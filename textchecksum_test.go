@@ -0,0 +1,38 @@
+package base58
+
+import (
+	"hash/crc32"
+	"testing"
+)
+
+func TestTextChecksum(t *testing.T) {
+	s := "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	if sum := TextChecksum(s); sum != crc32.ChecksumIEEE([]byte(s)) {
+		t.Errorf("unexpected checksum %08x", sum)
+	}
+
+	for _, text := range []string{s, "", "1"} {
+		withSum := AppendTextChecksum(text)
+		if len(withSum) != len(text)+6 {
+			t.Errorf("expected a 6 character suffix, got %s", withSum)
+		}
+		got, ok := VerifyTextChecksum(withSum)
+		if !ok || got != text {
+			t.Errorf("expected %q to verify, got %q, %v", text, got, ok)
+		}
+	}
+}
+
+func TestTextChecksumTampered(t *testing.T) {
+	withSum := AppendTextChecksum("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	for i := 0; i < len(withSum); i++ {
+		tampered := []byte(withSum)
+		tampered[i] = BTCAlphabet.encode[(BTCAlphabet.decode[withSum[i]]+1)%58]
+		if _, ok := VerifyTextChecksum(string(tampered)); ok {
+			t.Errorf("expected tampering at index %d to be detected", i)
+		}
+	}
+	if _, ok := VerifyTextChecksum("abc"); ok {
+		t.Errorf("expected a short string to fail verification")
+	}
+}
@@ -0,0 +1,68 @@
+package base58
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrTokenExpired is returned by DecodeToken when the token's expiry has
+	// passed.
+	ErrTokenExpired = errors.New("base58 token has expired")
+	// ErrBadSignature is returned by DecodeToken when the token's HMAC does
+	// not match.
+	ErrBadSignature = errors.New("base58 token has an invalid signature")
+)
+
+const (
+	tokenExpiryLen = 8
+	tokenTagLen    = sha256.Size
+)
+
+// EncodeToken returns a signed, time-limited token: the base58 encoding, with
+// the passed alphabet, of the payload, the expiry as a big-endian unix
+// timestamp in seconds, and an HMAC-SHA256 tag over both keyed by key.
+//
+// The payload is signed but not encrypted.
+func EncodeToken(payload []byte, expiry time.Time, key []byte, alph *Alphabet) string {
+	buf := make([]byte, len(payload), len(payload)+tokenExpiryLen+tokenTagLen)
+	copy(buf, payload)
+	var exp [tokenExpiryLen]byte
+	binary.BigEndian.PutUint64(exp[:], uint64(expiry.Unix()))
+	buf = append(buf, exp[:]...)
+	buf = append(buf, tokenTag(buf, key)...)
+	return FastBase58EncodingAlphabet(buf, alph)
+}
+
+// DecodeToken verifies a token produced by EncodeToken and returns its
+// payload. It returns ErrBadSignature if the tag does not match and
+// ErrTokenExpired if the expiry has passed.
+func DecodeToken(s string, key []byte, alph *Alphabet) (payload []byte, err error) {
+	buf, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < tokenExpiryLen+tokenTagLen {
+		return nil, fmt.Errorf("token is too short: %d bytes", len(buf))
+	}
+
+	signed, tag := buf[:len(buf)-tokenTagLen], buf[len(buf)-tokenTagLen:]
+	if !hmac.Equal(tag, tokenTag(signed, key)) {
+		return nil, ErrBadSignature
+	}
+	payload, exp := signed[:len(signed)-tokenExpiryLen], signed[len(signed)-tokenExpiryLen:]
+	if time.Now().Unix() >= int64(binary.BigEndian.Uint64(exp)) {
+		return nil, ErrTokenExpired
+	}
+	return payload, nil
+}
+
+func tokenTag(b, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestToken(t *testing.T) {
+	key := []byte("secret")
+	payload := []byte{0, 1, 2, 3}
+
+	tok := EncodeToken(payload, time.Now().Add(time.Hour), key, BTCAlphabet)
+	got, err := DecodeToken(tok, key, BTCAlphabet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("expected %x, got %x", payload, got)
+	}
+
+	if _, err := DecodeToken(tok, []byte("other"), BTCAlphabet); err != ErrBadSignature {
+		t.Errorf("expected ErrBadSignature for wrong key, got %v", err)
+	}
+
+	expired := EncodeToken(payload, time.Now().Add(-time.Minute), key, BTCAlphabet)
+	if _, err := DecodeToken(expired, key, BTCAlphabet); err != ErrTokenExpired {
+		t.Errorf("expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func TestTokenTampered(t *testing.T) {
+	key := []byte("secret")
+	tok := EncodeToken([]byte("capability"), time.Now().Add(time.Hour), key, BTCAlphabet)
+
+	buf, _ := FastBase58Decoding(tok)
+	buf[0] ^= 0x01
+	if _, err := DecodeToken(FastBase58Encoding(buf), key, BTCAlphabet); err != ErrBadSignature {
+		t.Errorf("expected ErrBadSignature for tampered payload, got %v", err)
+	}
+
+	if _, err := DecodeToken(FastBase58Encoding([]byte("short")), key, BTCAlphabet); err == nil {
+		t.Errorf("expected error for short token")
+	}
+	if _, err := DecodeToken("0OIl", key, BTCAlphabet); err == nil {
+		t.Errorf("expected error for invalid base58")
+	}
+}
@@ -0,0 +1,62 @@
+package base58
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// EncodeWithLengthPrefix encodes the passed bytes with the passed alphabet
+// and prefixes the result with its length in characters, written as a
+// decimal number followed by a colon (e.g. "4:2NEpo"). Such values can be
+// concatenated and read back with a Tokenizer.
+func EncodeWithLengthPrefix(src []byte, alph *Alphabet) string {
+	enc := FastBase58EncodingAlphabet(src, alph)
+	return strconv.Itoa(len(enc)) + ":" + enc
+}
+
+// Tokenizer decodes a sequence of concatenated values produced by
+// EncodeWithLengthPrefix, one value at a time.
+type Tokenizer struct {
+	data []byte
+	pos  int
+	alph *Alphabet
+}
+
+// NewTokenizer returns a Tokenizer reading values from data, decoded with
+// the passed alphabet.
+func NewTokenizer(data []byte, alph *Alphabet) *Tokenizer {
+	return &Tokenizer{data: data, alph: alph}
+}
+
+// Next decodes and returns the next value. It returns io.EOF once all values
+// have been read.
+func (t *Tokenizer) Next() ([]byte, error) {
+	if t.pos == len(t.data) {
+		return nil, io.EOF
+	}
+
+	start := t.pos
+	n := 0
+	for ; t.pos < len(t.data) && t.data[t.pos] != ':'; t.pos++ {
+		c := t.data[t.pos]
+		if c < '0' || c > '9' || t.pos-start >= 9 {
+			return nil, fmt.Errorf("invalid length prefix at input index: %d", start)
+		}
+		n = n*10 + int(c-'0')
+	}
+	if t.pos == start || t.pos == len(t.data) {
+		return nil, fmt.Errorf("invalid length prefix at input index: %d", start)
+	}
+	t.pos++
+
+	if n > len(t.data)-t.pos {
+		return nil, fmt.Errorf("length prefix %d exceeds remaining %d bytes", n, len(t.data)-t.pos)
+	}
+	enc := t.data[t.pos : t.pos+n]
+	t.pos += n
+	if n == 0 {
+		return []byte{}, nil
+	}
+	return FastBase58DecodingAlphabet(string(enc), t.alph)
+}
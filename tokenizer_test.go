@@ -0,0 +1,42 @@
+package base58
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestTokenizer(t *testing.T) {
+	values := [][]byte{
+		{0, 0, 1},
+		bytes.Repeat([]byte{0xFF}, 32),
+		{},
+		[]byte("hello"),
+	}
+	var data []byte
+	for _, v := range values {
+		data = append(data, EncodeWithLengthPrefix(v, BTCAlphabet)...)
+	}
+
+	tok := NewTokenizer(data, BTCAlphabet)
+	for i, want := range values {
+		got, err := tok.Next()
+		if err != nil {
+			t.Fatalf("value %d: unexpected error: %v", i, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("value %d: expected %x, got %x", i, want, got)
+		}
+	}
+	if _, err := tok.Next(); err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestTokenizerInvalid(t *testing.T) {
+	for _, s := range []string{"5:abc", "abc", ":abc", "3", "3:0OI", "9999999999:1"} {
+		if _, err := NewTokenizer([]byte(s), BTCAlphabet).Next(); err == nil || err == io.EOF {
+			t.Errorf("expected error for %q, got %v", s, err)
+		}
+	}
+}
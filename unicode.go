@@ -0,0 +1,66 @@
+package base58
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// UnicodeAlphabet is a base58 alphabet of 58 arbitrary runes.
+//
+// It works at the rune level and uses a map for the reverse lookup, so it
+// is considerably slower than the ASCII Alphabet and should only be used
+// when non-ASCII symbols are required.
+type UnicodeAlphabet struct {
+	decode map[rune]byte
+	encode [58]rune
+}
+
+// NewUnicodeAlphabet creates a new alphabet from the passed runes.
+//
+// It returns an error if there are not exactly 58 distinct, valid runes.
+func NewUnicodeAlphabet(symbols []rune) (*UnicodeAlphabet, error) {
+	if len(symbols) != 58 {
+		return nil, fmt.Errorf("base58 alphabets must be 58 runes long")
+	}
+	ret := &UnicodeAlphabet{decode: make(map[rune]byte, 58)}
+	for i, r := range symbols {
+		if !utf8.ValidRune(r) {
+			return nil, fmt.Errorf("provided alphabet contains invalid rune (%U)", r)
+		}
+		if _, ok := ret.decode[r]; ok {
+			return nil, fmt.Errorf("provided alphabet does not consist of 58 distinct runes")
+		}
+		ret.decode[r] = byte(i)
+		ret.encode[i] = r
+	}
+	return ret, nil
+}
+
+// Encode encodes the passed bytes into a base58 encoded string.
+func (a *UnicodeAlphabet) Encode(bin []byte) string {
+	digits := _FastBase58EncodingAlphabetBytes(bin, BTCAlphabet)
+	var sb strings.Builder
+	for _, d := range digits {
+		sb.WriteRune(a.encode[BTCAlphabet.decode[d]])
+	}
+	return sb.String()
+}
+
+// Decode decodes the base58 encoded string.
+func (a *UnicodeAlphabet) Decode(str string) ([]byte, error) {
+	if len(str) == 0 {
+		return nil, fmt.Errorf("zero length string")
+	}
+	digits := make([]byte, 0, len(str))
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		d, ok := a.decode[r]
+		if !ok || (r == utf8.RuneError && size == 1) {
+			return nil, fmt.Errorf("invalid base58 digit (%q) at input index: %d", r, i)
+		}
+		digits = append(digits, BTCAlphabet.encode[d])
+		i += size
+	}
+	return FastBase58DecodingAlphabet(string(digits), BTCAlphabet)
+}
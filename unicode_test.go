@@ -0,0 +1,62 @@
+package base58
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+)
+
+func emojiAlphabet(t *testing.T) *UnicodeAlphabet {
+	symbols := make([]rune, 58)
+	for i := range symbols {
+		symbols[i] = 0x1F600 + rune(i)
+	}
+	alph, err := NewUnicodeAlphabet(symbols)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return alph
+}
+
+func TestUnicodeAlphabetRoundTrip(t *testing.T) {
+	alph := emojiAlphabet(t)
+	for j := 1; j < 64; j++ {
+		b := make([]byte, j)
+		rand.Read(b)
+		if j%3 == 0 {
+			b[0] = 0
+		}
+		enc := alph.Encode(b)
+		if utf8.RuneCountInString(enc) != len(FastBase58Encoding(b)) {
+			t.Errorf("expected one rune per digit, got %s", enc)
+		}
+		dec, err := alph.Decode(enc)
+		if err != nil {
+			t.Errorf("unexpected error decoding %s: %v", enc, err)
+		}
+		if !bytes.Equal(dec, b) {
+			t.Errorf("expected %x, got %x", b, dec)
+		}
+	}
+
+	if enc := alph.Encode([]byte{0, 57}); enc != "\U0001F600\U0001F639" {
+		t.Errorf("unexpected encoding %q", enc)
+	}
+}
+
+func TestUnicodeAlphabetInvalid(t *testing.T) {
+	if _, err := NewUnicodeAlphabet([]rune(btcDigits[1:])); err == nil {
+		t.Errorf("expected error on alphabet being too short")
+	}
+	if _, err := NewUnicodeAlphabet([]rune("z" + btcDigits[1:])); err == nil {
+		t.Errorf("expected error on duplicate runes")
+	}
+
+	alph := emojiAlphabet(t)
+	for _, s := range []string{"", "abc", "\U0001F600\xF0"} {
+		if _, err := alph.Decode(s); err == nil {
+			t.Errorf("expected error decoding %q", s)
+		}
+	}
+}
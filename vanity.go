@@ -0,0 +1,15 @@
+package base58
+
+import "strings"
+
+// HasVanityPrefix reports whether the base58 (bitcoin alphabet) encoding of
+// the passed public key starts with prefix.
+func HasVanityPrefix(pubkey [32]byte, prefix string) bool {
+	return strings.HasPrefix(FastBase58EncodingAlphabet(pubkey[:], BTCAlphabet), prefix)
+}
+
+// ValidVanityPrefix returns an error if prefix contains characters outside
+// of the bitcoin alphabet, since such a prefix can never be matched.
+func ValidVanityPrefix(prefix string) error {
+	return checkDigits(prefix, BTCAlphabet)
+}
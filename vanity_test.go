@@ -0,0 +1,32 @@
+package base58
+
+import "testing"
+
+func TestHasVanityPrefix(t *testing.T) {
+	dec, err := FastBase58Decoding("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	var key [32]byte
+	copy(key[:], dec)
+
+	if !HasVanityPrefix(key, "Token") {
+		t.Errorf("expected key to match prefix %q", "Token")
+	}
+	if HasVanityPrefix(key, "Tokex") {
+		t.Errorf("expected key not to match prefix %q", "Tokex")
+	}
+}
+
+func TestValidVanityPrefix(t *testing.T) {
+	for _, p := range []string{"", "Token", "abc123"} {
+		if err := ValidVanityPrefix(p); err != nil {
+			t.Errorf("expected prefix %q to be valid, got %v", p, err)
+		}
+	}
+	for _, p := range []string{"0x", "Sol", "I", "l", "\xFF"} {
+		if err := ValidVanityPrefix(p); err == nil {
+			t.Errorf("expected prefix %q to be rejected", p)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+)
+
+type vector struct {
+	dec []byte
+	enc string
+}
+
+// implementationVectors are canonical bitcoin base58 test vectors.
+var implementationVectors = []vector{
+	{mustDecodeHex("61"), "2g"},
+	{mustDecodeHex("626262"), "a3gV"},
+	{mustDecodeHex("73696d706c792061206c6f6e6720737472696e67"), "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+	{mustDecodeHex("00eb15231dfceb60925886b67d065299925915aeb172c06647"), "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+	{mustDecodeHex("bf4f89001e670274dd"), "3SEo3LWLoPntC"},
+	{mustDecodeHex("ecac89cad93923c02321"), "EJDM8drfXA6uyA"},
+	{mustDecodeHex("00000000000000000000"), "1111111111"},
+	{mustDecodeHex("000111d38e5fc9071ffcd20b4a763cc9ae4f252bb4e48fd66a835e252ada93ff480d6dd43dc62a641155a5"), "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"},
+}
+
+// VerifyImplementation runs a small set of canonical test vectors through
+// both the fast and the trivial encoders and decoders, and returns an error
+// describing the first mismatch. It is cheap enough to call at startup, so
+// that a miscompiled build can refuse to run.
+func VerifyImplementation() error {
+	return verifyVectors(implementationVectors)
+}
+
+func verifyVectors(vectors []vector) error {
+	// every vector fits in these, so the fast paths don't allocate; errors
+	// format copies of them so that they stay on the stack
+	var encBuf, decBuf [64]byte
+	var scratch [16]uint32
+	for i, v := range vectors {
+		if len(v.dec)*555/406+1 > len(encBuf) || len(v.enc) > len(decBuf) {
+			return fmt.Errorf("vector %d: too long for verification", i)
+		}
+		if enc := encodeInto(encBuf[:], v.dec, BTCAlphabet); string(enc) != v.enc {
+			return fmt.Errorf("vector %d: fast encoding is %s, expected %s", i, string(enc), v.enc)
+		}
+		if enc := TrivialBase58Encoding(v.dec); enc != v.enc {
+			return fmt.Errorf("vector %d: trivial encoding is %s, expected %s", i, enc, v.enc)
+		}
+		n, err := decodeInto(decBuf[:], nil, scratch[:], v.enc, BTCAlphabet)
+		if got := decBuf[:n]; err != nil || !bytes.Equal(got, v.dec) {
+			return fmt.Errorf("vector %d: fast decoding is %x (%v), expected %x", i, string(got), err, v.dec)
+		}
+		if got, err := TrivialBase58Decoding(v.enc); err != nil || !bytes.Equal(got, v.dec) {
+			return fmt.Errorf("vector %d: trivial decoding is %x (%v), expected %x", i, got, err, v.dec)
+		}
+	}
+	return nil
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,33 @@
+package base58
+
+import "testing"
+
+func TestVerifyImplementation(t *testing.T) {
+	if err := VerifyImplementation(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestVerifyVectorsDetectsMismatch(t *testing.T) {
+	broken := append([]vector{}, implementationVectors...)
+	broken[2].enc = "2cFupjhnEsSn59qHXstmK2ffpLv3"
+	if err := verifyVectors(broken); err == nil {
+		t.Errorf("expected a broken vector to be detected")
+	}
+	if err := verifyVectors([]vector{{[]byte{0x62}, "2g"}}); err == nil {
+		t.Errorf("expected a mismatched vector to be detected")
+	}
+}
+
+func TestVerifyImplementationAllocs(t *testing.T) {
+	trivial := testing.AllocsPerRun(10, func() {
+		for _, v := range implementationVectors {
+			TrivialBase58Encoding(v.dec)
+			TrivialBase58Decoding(v.enc)
+		}
+	})
+	allocs := testing.AllocsPerRun(10, func() { VerifyImplementation() })
+	if allocs > trivial {
+		t.Errorf("VerifyImplementation made %v allocations, expected no more than the %v of the trivial path", allocs, trivial)
+	}
+}
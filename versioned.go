@@ -0,0 +1,94 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// ChecksumAlgo identifies the checksum algorithm of a versioned check
+// encoding. It is stored as the leading byte of the encoded data.
+type ChecksumAlgo byte
+
+// The supported checksum algorithms. Each produces a 4 byte checksum.
+const (
+	// DoubleSHA256 is the first 4 bytes of SHA256(SHA256(data)), as used by
+	// Base58Check.
+	DoubleSHA256 ChecksumAlgo = iota + 1
+	// SingleSHA256 is the first 4 bytes of SHA256(data).
+	SingleSHA256
+	// CRC32 is the big-endian IEEE CRC-32 of data.
+	CRC32
+	// Blake2b is the first 4 bytes of the 256 bit BLAKE2b digest of data.
+	Blake2b
+)
+
+// ErrUnknownChecksumAlgo is returned by DecodeVersionedCheck when the leading
+// byte does not identify a supported checksum algorithm.
+var ErrUnknownChecksumAlgo = errors.New("unknown checksum algorithm")
+
+// sum returns the 4 byte checksum of b, and false if the algorithm is
+// unknown.
+func (algo ChecksumAlgo) sum(b []byte) ([checksumLen]byte, bool) {
+	var sum [checksumLen]byte
+	switch algo {
+	case DoubleSHA256:
+		sum = checksum(b)
+	case SingleSHA256:
+		h := sha256.Sum256(b)
+		copy(sum[:], h[:])
+	case CRC32:
+		binary.BigEndian.PutUint32(sum[:], crc32.ChecksumIEEE(b))
+	case Blake2b:
+		h := blake2b.Sum256(b)
+		copy(sum[:], h[:])
+	default:
+		return sum, false
+	}
+	return sum, true
+}
+
+// EncodeVersionedCheck returns the base58 (bitcoin alphabet) encoding of the
+// algorithm byte, the payload and a checksum computed by algo over both.
+//
+// It panics if algo is not a supported checksum algorithm.
+func EncodeVersionedCheck(payload []byte, algo ChecksumAlgo) string {
+	buf := make([]byte, 0, 1+len(payload)+checksumLen)
+	buf = append(buf, byte(algo))
+	buf = append(buf, payload...)
+	sum, ok := algo.sum(buf)
+	if !ok {
+		panic("unknown base58 checksum algorithm")
+	}
+	return FastBase58EncodingAlphabet(append(buf, sum[:]...), BTCAlphabet)
+}
+
+// DecodeVersionedCheck decodes a string produced by EncodeVersionedCheck,
+// verifying its checksum with the algorithm named by the leading byte.
+//
+// It returns ErrUnknownChecksumAlgo if that byte is not a supported
+// algorithm and ErrChecksumMismatch if the checksum does not match.
+func DecodeVersionedCheck(s string) (payload []byte, algo ChecksumAlgo, err error) {
+	buf, err := FastBase58DecodingAlphabet(s, BTCAlphabet)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(buf) < 1+checksumLen {
+		return nil, 0, fmt.Errorf("versioned check data is too short: %d bytes", len(buf))
+	}
+	algo = ChecksumAlgo(buf[0])
+	data := buf[:len(buf)-checksumLen]
+	sum, ok := algo.sum(data)
+	if !ok {
+		return nil, 0, ErrUnknownChecksumAlgo
+	}
+	if !bytes.Equal(sum[:], buf[len(data):]) {
+		return nil, 0, ErrChecksumMismatch
+	}
+	return data[1:], algo, nil
+}
@@ -0,0 +1,51 @@
+package base58
+
+import (
+	"bytes"
+	"testing"
+)
+
+var algos = []ChecksumAlgo{DoubleSHA256, SingleSHA256, CRC32, Blake2b}
+
+func TestVersionedCheckRoundTrip(t *testing.T) {
+	for _, payload := range [][]byte{{}, {0, 0, 1}, []byte("versioned payload")} {
+		for _, algo := range algos {
+			s := EncodeVersionedCheck(payload, algo)
+			dec, decAlgo, err := DecodeVersionedCheck(s)
+			if err != nil {
+				t.Errorf("algo %d: unexpected error for %s: %v", algo, s, err)
+				continue
+			}
+			if decAlgo != algo {
+				t.Errorf("expected algo %d, got %d", algo, decAlgo)
+			}
+			if !bytes.Equal(dec, payload) {
+				t.Errorf("algo %d: expected %x, got %x", algo, payload, dec)
+			}
+		}
+	}
+}
+
+func TestVersionedCheckCrossAlgo(t *testing.T) {
+	for _, from := range algos {
+		buf, _ := FastBase58Decoding(EncodeVersionedCheck([]byte("payload"), from))
+		for _, to := range algos {
+			if to == from {
+				continue
+			}
+			buf[0] = byte(to)
+			if _, _, err := DecodeVersionedCheck(FastBase58Encoding(buf)); err != ErrChecksumMismatch {
+				t.Errorf("expected algo %d checksum to fail verification as algo %d, got %v", from, to, err)
+			}
+		}
+
+		buf[0] = 0xEE
+		if _, _, err := DecodeVersionedCheck(FastBase58Encoding(buf)); err != ErrUnknownChecksumAlgo {
+			t.Errorf("expected ErrUnknownChecksumAlgo, got %v", err)
+		}
+	}
+
+	if _, _, err := DecodeVersionedCheck("1"); err == nil {
+		t.Errorf("expected error on short input")
+	}
+}
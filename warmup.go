@@ -0,0 +1,36 @@
+package base58
+
+import "sync"
+
+var warmupOnce sync.Once
+
+// Warmup eagerly initializes any state the package would otherwise set up on
+// first use, so that the first request served doesn't pay for it.
+//
+// All tables are built at init time, so for them Warmup only runs an encode
+// and decode through every registered alphabet to bring them into memory.
+// It also seeds the scratch pool used by DecodeIntoRing for long strings;
+// the pool may be drained by a garbage collection, so that part is best
+// effort. It is idempotent and safe to call concurrently.
+func Warmup() {
+	warmupOnce.Do(func() {
+		registry.RLock()
+		alphabets := make([]*Alphabet, 0, len(registry.byCode))
+		for _, a := range registry.byCode {
+			alphabets = append(alphabets, a)
+		}
+		registry.RUnlock()
+
+		// a non-zero sample, so that the digit loops run and not only the
+		// leading-zero paths
+		var sample [32]byte
+		for i := range sample {
+			sample[i] = byte(0xFF - i)
+		}
+		for _, a := range alphabets {
+			FastBase58DecodingAlphabet(FastBase58EncodingAlphabet(sample[:], a), a)
+		}
+
+		ringScratchPool.Put(ringScratchPool.Get())
+	})
+}
@@ -0,0 +1,26 @@
+package base58
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestWarmup(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Warmup()
+		}()
+	}
+	wg.Wait()
+	Warmup()
+
+	src := []byte{0, 1, 2, 3}
+	dec, err := Decode(Encode(src))
+	if err != nil || !bytes.Equal(dec, src) {
+		t.Errorf("expected %x after warmup, got %x (%v)", src, dec, err)
+	}
+}
@@ -0,0 +1,33 @@
+package base58
+
+import "errors"
+
+// ErrZeroValue is returned by DecodeNonZero when every decoded byte is zero.
+var ErrZeroValue = errors.New("decoded value is all zeros")
+
+// EncodeWithLeadingZeroWarning encodes the passed bytes with the passed
+// alphabet and also reports whether the input starts with at least threshold
+// zero bytes, which often indicates an uninitialized key.
+func EncodeWithLeadingZeroWarning(src []byte, threshold int, alph *Alphabet) (string, bool) {
+	zcount := 0
+	for zcount < len(src) && src[zcount] == 0 {
+		zcount++
+	}
+	return FastBase58EncodingAlphabet(src, alph), zcount >= threshold
+}
+
+// DecodeNonZero decodes the base58 encoded string using the passed alphabet
+// and returns ErrZeroValue if every decoded byte is zero, i.e. if s consists
+// only of zero digits, such as the default (all-zero) Solana key.
+func DecodeNonZero(s string, alph *Alphabet) ([]byte, error) {
+	b, err := FastBase58DecodingAlphabet(s, alph)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range b {
+		if c != 0 {
+			return b, nil
+		}
+	}
+	return nil, ErrZeroValue
+}
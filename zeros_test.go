@@ -0,0 +1,36 @@
+package base58
+
+import "testing"
+
+func TestEncodeWithLeadingZeroWarning(t *testing.T) {
+	enc, warn := EncodeWithLeadingZeroWarning(make([]byte, 32), 8, BTCAlphabet)
+	if enc != "11111111111111111111111111111111" || !warn {
+		t.Errorf("expected a warning for the all-zero key, got %s, %v", enc, warn)
+	}
+
+	key, _ := FastBase58Decoding("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	enc, warn = EncodeWithLeadingZeroWarning(key, 8, BTCAlphabet)
+	if enc != "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA" || warn {
+		t.Errorf("expected no warning for a normal key, got %s, %v", enc, warn)
+	}
+
+	if _, warn := EncodeWithLeadingZeroWarning([]byte{0, 0, 1}, 2, BTCAlphabet); !warn {
+		t.Errorf("expected a warning at the threshold")
+	}
+	if _, warn := EncodeWithLeadingZeroWarning([]byte{0, 1}, 2, BTCAlphabet); warn {
+		t.Errorf("expected no warning below the threshold")
+	}
+}
+
+func TestDecodeNonZero(t *testing.T) {
+	if _, err := DecodeNonZero("11111111111111111111111111111111", BTCAlphabet); err != ErrZeroValue {
+		t.Errorf("expected ErrZeroValue, got %v", err)
+	}
+	dec, err := DecodeNonZero("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", BTCAlphabet)
+	if err != nil || len(dec) != 32 {
+		t.Errorf("expected a 32 byte key, got %x (%v)", dec, err)
+	}
+	if _, err := DecodeNonZero("0OIl", BTCAlphabet); err == nil || err == ErrZeroValue {
+		t.Errorf("expected a decoding error, got %v", err)
+	}
+}